@@ -0,0 +1,207 @@
+package enum
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// binding is implemented by Set to resolve encoded values
+// into canonical members without knowing the underlying type.
+type binding interface {
+	resolveJSON(data []byte) (any, error)
+	resolveSQL(value any) (any, error)
+	resolveText(text []byte) (any, error)
+	resolveYAML(node *yaml.Node) (any, error)
+	encode(format string, member any) ([]byte, error)
+	decode(format string, data []byte) (any, error)
+	position(member any) (int, error)
+	bit(i int) (uint64, error)
+	packed() bool
+	member(i int) any
+	size() int
+	defaultMember() any
+}
+
+var (
+	// Sets bound to their members type
+	bindings   = map[reflect.Type]binding{}
+	bindingsMu sync.RWMutex
+)
+
+// Bind binds the type of the members to the set and returns the set.
+// Ref and Flags values of that type resolve to the set members when decoded
+// and Ordered members compare in the order of the set.
+// The members are frozen like Freeze unless the set is built with WithoutFreeze:
+// decode fields as Ref to swap them to another member.
+// Bind must be called after the With methods returning copies of the set:
+// they panic with ErrSetBound on a bound set.
+// It panics if the type is already bound to another set.
+//
+// Example:
+//
+//	TestStates = enum.NewSet[string](
+//		TestStateUnknown,
+//		TestStatePassed,
+//	).Bind()
+func (s *Set[T]) Bind() *Set[T] {
+	t := reflect.TypeOf(s.members[0])
+	bindingsMu.Lock()
+	defer bindingsMu.Unlock()
+	if b, ok := bindings[t]; ok && b != binding(s) {
+		panic(fmt.Errorf("%w: '%v'", ErrAlreadyBound, t))
+	}
+	bindings[t] = s
+	for _, e := range s.members {
+		if o, ok := e.(interface{ bindOrdered(*Set[T]) }); ok {
+			o.bindOrdered(s)
+		}
+	}
+	if !s.mutable {
+		s.Freeze()
+	}
+	return s
+}
+
+// mustNotBeBound panics if the set is bound.
+// The With methods return copies of the set that would not be bound,
+// so Bind must be called after them.
+func (s *Set[T]) mustNotBeBound() {
+	t := reflect.TypeOf(s.members[0])
+	bindingsMu.RLock()
+	b, ok := bindings[t]
+	bindingsMu.RUnlock()
+	if ok && b == binding(s) {
+		panic(fmt.Errorf("%w: '%v': call Bind after the With methods", ErrSetBound, t))
+	}
+}
+
+// ParseJSON parses the given JSON value into an Enummer.
+// It returns an UnknownValueError if the value is not a member of the set.
+func (s *Set[T]) ParseJSON(data []byte) (Enummer[T], error) {
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return nil, err
+	}
+	return s.TryParse(val)
+}
+
+// ParseSQL parses the given database value into an Enummer.
+// It accepts the same values as Enum.Scan.
+// It returns an UnknownValueError if the value is not a member of the set.
+func (s *Set[T]) ParseSQL(value any) (Enummer[T], error) {
+	val, err := scanValue[T](value)
+	if err != nil {
+		return nil, err
+	}
+	return s.TryParse(val)
+}
+
+// ParseText parses the given text into an Enummer.
+// Members are matched by value, then by name and alias,
+// according to the parse options of the set.
+// It returns an UnknownValueError if the text does not match any member of the set.
+func (s *Set[T]) ParseText(text []byte) (Enummer[T], error) {
+	if e := s.lookupText(string(text)); e != nil {
+		return e, nil
+	}
+	return nil, &UnknownValueError{Value: string(text), Type: reflect.TypeOf(s.members[0])}
+}
+
+// resolveJSON implements the binding interface.
+func (s *Set[T]) resolveJSON(data []byte) (any, error) {
+	return s.ParseJSON(data)
+}
+
+// resolveSQL implements the binding interface.
+func (s *Set[T]) resolveSQL(value any) (any, error) {
+	return s.ParseSQL(value)
+}
+
+// resolveText implements the binding interface.
+func (s *Set[T]) resolveText(text []byte) (any, error) {
+	return s.ParseText(text)
+}
+
+// bindingOf returns the set bound to the type E.
+func bindingOf[E any]() (binding, error) {
+	return lookupBinding(reflect.TypeOf((*E)(nil)).Elem())
+}
+
+// lookupBinding returns the set bound to the given type.
+func lookupBinding(t reflect.Type) (binding, error) {
+	bindingsMu.RLock()
+	defer bindingsMu.RUnlock()
+	b, ok := bindings[t]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%v'", ErrNotBound, t)
+	}
+	return b, nil
+}
+
+// position implements the binding interface.
+func (s *Set[T]) position(member any) (int, error) {
+	e, ok := member.(Enummer[T])
+	if !ok {
+		return -1, &TypeMismatchError{A: reflect.TypeOf(member), B: reflect.TypeOf(s.members[0])}
+	}
+	return s.TryIndex(e)
+}
+
+// member implements the binding interface.
+func (s *Set[T]) member(i int) any {
+	return s.members[i]
+}
+
+// size implements the binding interface.
+func (s *Set[T]) size() int {
+	return len(s.members)
+}
+
+// defaultMember implements the binding interface.
+// It returns nil if the set has no default member.
+func (s *Set[T]) defaultMember() any {
+	if s.def == nil {
+		return nil
+	}
+	return s.def
+}
+
+// memberText returns the text form of the member:
+// the name of int members if set, otherwise the text of the value.
+func memberText(member any) (string, error) {
+	if m, ok := member.(interface{ nameOrValue() string }); ok {
+		return m.nameOrValue(), nil
+	}
+	m, ok := member.(encoding.TextMarshaler)
+	if !ok {
+		return "", fmt.Errorf("enum: '%T' does not implement encoding.TextMarshaler", member)
+	}
+	text, err := m.MarshalText()
+	return string(text), err
+}
+
+// resolveList resolves the members of the list separated by sep.
+// Spaces and double quotes around the members are removed.
+// An empty list has no member.
+func resolveList(b binding, list, sep string) ([]any, error) {
+	if strings.TrimSpace(list) == "" {
+		return nil, nil
+	}
+	parts := strings.Split(list, sep)
+	members := make([]any, len(parts))
+	for i, part := range parts {
+		part = strings.Trim(strings.TrimSpace(part), "\"")
+		member, err := b.resolveText([]byte(part))
+		if err != nil {
+			return nil, err
+		}
+		members[i] = member
+	}
+	return members, nil
+}
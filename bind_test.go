@@ -0,0 +1,69 @@
+package enum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSet_Bind(t *testing.T) {
+	require.Same(t, TestRefs, TestRefs.Bind())
+	require.Panics(t, func() { NewSet[string](TestRefHello).Bind() })
+}
+
+func TestSet_ParseJSON(t *testing.T) {
+	got, err := TestRefs.ParseJSON([]byte("\"world\""))
+	require.NoError(t, err)
+	require.Same(t, TestRefWorld, got)
+
+	_, err = TestRefs.ParseJSON([]byte("\"foo\""))
+	require.ErrorIs(t, err, ErrUnknownValue)
+	var unknownErr *UnknownValueError
+	require.ErrorAs(t, err, &unknownErr)
+	require.Equal(t, "foo", unknownErr.Value)
+
+	_, err = TestRefs.ParseJSON([]byte("1"))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrUnknownValue)
+}
+
+func TestSet_ParseText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    *TestRefInt
+		wantErr error
+	}{
+		{
+			name: "value",
+			text: "2",
+			want: TestRefTwo,
+		},
+		{
+			name: "name",
+			text: "Two",
+			want: TestRefTwo,
+		},
+		{
+			name:    "unknown value",
+			text:    "3",
+			wantErr: ErrUnknownValue,
+		},
+		{
+			name:    "unknown name",
+			text:    "Three",
+			wantErr: ErrUnknownValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TestRefInts.ParseText([]byte(tt.text))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Same(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// fileTemplate is the template of the generated file.
+var fileTemplate = template.Must(template.New("file").Funcs(template.FuncMap{
+	"literal": literal,
+	"value":   valueLiteral,
+	"join":    strings.Join,
+}).Parse(`// Code generated by go-struct-enum. DO NOT EDIT.
+
+package {{ .Package }}
+
+import enum "github.com/FabienMht/go-struct-enum"
+{{ range .Enums }}{{ $enum := . }}
+{{- $args := "e, other" }}{{ if .Default }}{{ $args = "e.orDefault(), other.orDefault()" }}{{ end }}
+// {{ .Name }} is an enum of {{ .Type }}.
+type {{ .Name }} struct {
+	enum.Enum[{{ .Type }}]
+}
+
+var (
+{{- range .Members }}
+	{{ $enum.Name }}{{ .Name }} = &{{ $enum.Name }}{enum.New({{ value $enum.Type .Value }}
+		{{- if .DisplayName }}, enum.WithName({{ literal .DisplayName }}){{ end }}
+		{{- if .Description }}, enum.WithDescription({{ literal .Description }}){{ end }}
+		{{- if .Aliases }}, enum.WithAliases({{ range $i, $a := .Aliases }}{{ if $i }}, {{ end }}{{ literal $a }}{{ end }}){{ end }}
+		{{- if .Rank }}, enum.WithRank({{ .Rank }}){{ end }}
+		{{- if .Replacement }}, enum.WithReplacement({{ $enum.Name }}{{ .Replacement }})
+		{{- else if .Deprecated }}, enum.WithDeprecated(){{ end }})}
+{{- end }}
+
+	// {{ .Set }} is the ordered set of {{ .Name }}.
+	{{ .Set }} = enum.NewSet[{{ .Type }}](
+{{- range .Members }}
+		{{ $enum.Name }}{{ .Name }},
+{{- end }}
+	)
+	{{- if .ParseOptions }}.WithParseOptions({{ join .ParseOptions ", " }}){{ end }}
+	{{- if .Default }}.WithDefault({{ .Name }}{{ .Default }}){{ end }}
+	{{- if .StrictOrder }}.WithStrictOrder(){{ end }}
+	{{- if .Mutable }}.WithoutFreeze(){{ end }}.Bind()
+)
+
+// Parse{{ .Name }} parses the given value into a {{ .Name }}.
+// If the value is not found, it returns nil.
+func Parse{{ .Name }}(val {{ .Type }}) *{{ .Name }} {
+	e, _ := enum.ParseAs[*{{ .Name }}]({{ .Set }}, val)
+	return e
+}
+
+// MustParse{{ .Name }} parses the given value into a {{ .Name }}.
+// If the value is not found, it panics.
+func MustParse{{ .Name }}(val {{ .Type }}) *{{ .Name }} {
+	return enum.MustParseAs[*{{ .Name }}]({{ .Set }}, val)
+}
+
+// TryParse{{ .Name }} parses the given value into a {{ .Name }}.
+// If the value is not found, it returns an error.
+func TryParse{{ .Name }}(val {{ .Type }}) (*{{ .Name }}, error) {
+	return enum.TryParseAs[*{{ .Name }}]({{ .Set }}, val)
+}
+{{- if .Default }}
+
+// Parse{{ .Name }}OrDefault parses the given value into a {{ .Name }}.
+// If the value is not found, it returns {{ .Name }}{{ .Default }}.
+func Parse{{ .Name }}OrDefault(val {{ .Type }}) *{{ .Name }} {
+	if e, ok := enum.ParseAs[*{{ .Name }}]({{ .Set }}, val); ok {
+		return e
+	}
+	return {{ .Name }}{{ .Default }}
+}
+
+// orDefault returns {{ .Name }}{{ .Default }} if the {{ .Name }} is nil.
+func (e *{{ .Name }}) orDefault() *{{ .Name }} {
+	if e == nil {
+		return {{ .Name }}{{ .Default }}
+	}
+	return e
+}
+
+// String returns the string representation of the {{ .Name }}.
+// A nil {{ .Name }} is represented as {{ .Name }}{{ .Default }}.
+func (e *{{ .Name }}) String() string {
+	return e.orDefault().Enum.String()
+}
+
+// GetValue returns the value of the {{ .Name }}.
+// A nil {{ .Name }} has the value of {{ .Name }}{{ .Default }}.
+func (e *{{ .Name }}) GetValue() {{ .Type }} {
+	return e.orDefault().Enum.GetValue()
+}
+{{- else }}
+
+// String returns the string representation of the {{ .Name }}.
+// A nil {{ .Name }} is represented as an empty string.
+func (e *{{ .Name }}) String() string {
+	if e == nil {
+		return ""
+	}
+	return e.Enum.String()
+}
+
+// GetValue returns the value of the {{ .Name }}.
+// A nil {{ .Name }} has the zero value.
+func (e *{{ .Name }}) GetValue() {{ .Type }} {
+	if e == nil {
+		var zero {{ .Type }}
+		return zero
+	}
+	return e.Enum.GetValue()
+}
+{{- end }}
+{{- if .Compare }}
+
+// Equal returns true if the {{ .Name }} is equal to the other.
+func (e *{{ .Name }}) Equal(other *{{ .Name }}) bool {
+	return enum.Equal[{{ .Type }}]({{ $args }})
+}
+
+// GreaterThan returns true if the {{ .Name }} is greater than the other.
+func (e *{{ .Name }}) GreaterThan(other *{{ .Name }}) bool {
+	return {{ .Set }}.GreaterThan({{ $args }})
+}
+
+// GreaterThanOrEqual returns true if the {{ .Name }} is greater than or equal to the other.
+func (e *{{ .Name }}) GreaterThanOrEqual(other *{{ .Name }}) bool {
+	return {{ .Set }}.GreaterThanOrEqual({{ $args }})
+}
+
+// LessThan returns true if the {{ .Name }} is less than the other.
+func (e *{{ .Name }}) LessThan(other *{{ .Name }}) bool {
+	return {{ .Set }}.LessThan({{ $args }})
+}
+
+// LessThanOrEqual returns true if the {{ .Name }} is less than or equal to the other.
+func (e *{{ .Name }}) LessThanOrEqual(other *{{ .Name }}) bool {
+	return {{ .Set }}.LessThanOrEqual({{ $args }})
+}
+
+// Compare returns -1 if the {{ .Name }} is lower than the other, 0 if equal and +1 if higher.
+func (e *{{ .Name }}) Compare(other *{{ .Name }}) int {
+	return {{ .Set }}.Compare({{ $args }})
+}
+{{- end }}
+{{ end }}`))
+
+// generate returns the formatted Go source of the enums described by the spec.
+func generate(s *spec) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, s); err != nil {
+		return nil, fmt.Errorf("cannot execute template: %w", err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("cannot format generated code: %w", err)
+	}
+	return src, nil
+}
+
+// literal returns the Go literal of the given string.
+func literal(s string) string {
+	return strconv.Quote(s)
+}
+
+// valueLiteral returns the Go literal of the member value.
+// Integers of another type than int are converted,
+// so that enum.New infers the underlying type.
+func valueLiteral(typ string, value any) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	}
+	if typ == "int" {
+		return fmt.Sprintf("%d", value)
+	}
+	return fmt.Sprintf("%s(%d)", typ, value)
+}
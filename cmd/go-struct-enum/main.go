@@ -0,0 +1,60 @@
+// Command go-struct-enum generates enum declarations from a YAML or JSON spec.
+//
+// It generates for each enum the struct type embedding enum.Enum, the members,
+// the bound set, typed parse functions and optionally the comparison methods.
+//
+// Usage:
+//
+//	go-struct-enum -spec states.yaml [-output states_enum.go] [-package states]
+//
+// With go generate:
+//
+//	//go:generate go run github.com/FabienMht/go-struct-enum/cmd/go-struct-enum -spec states.yaml
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "go-struct-enum:", err)
+		os.Exit(1)
+	}
+}
+
+// run parses the command line arguments and generates the enums.
+func run(args []string) error {
+	flags := flag.NewFlagSet("go-struct-enum", flag.ContinueOnError)
+	specPath := flags.String("spec", "", "path of the YAML or JSON spec (required)")
+	output := flags.String("output", "", "path of the generated file (default: <spec>_enum.go)")
+	// The package is set by go generate
+	pkg := flags.String("package", os.Getenv("GOPACKAGE"), "package of the generated file if not set in the spec")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *specPath == "" {
+		return fmt.Errorf("missing -spec flag")
+	}
+	if *output == "" {
+		*output = strings.TrimSuffix(*specPath, filepath.Ext(*specPath)) + "_enum.go"
+	}
+
+	data, err := os.ReadFile(*specPath)
+	if err != nil {
+		return err
+	}
+	s, err := parseSpec(data, *pkg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *specPath, err)
+	}
+	src, err := generate(s)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*output, src, 0o644)
+}
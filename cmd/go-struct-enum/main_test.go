@@ -0,0 +1,81 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	want, err := os.ReadFile("testdata/states.golden")
+	require.NoError(t, err)
+
+	output := filepath.Join(t.TempDir(), "states_enum.go")
+	require.NoError(t, run([]string{"-spec", "testdata/states.yaml", "-output", output}))
+	got, err := os.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got))
+}
+
+func TestRun_TypeCheck(t *testing.T) {
+	for _, name := range []string{"states", "types"} {
+		t.Run(name, func(t *testing.T) {
+			output := filepath.Join(t.TempDir(), name+"_enum.go")
+			require.NoError(t, run([]string{"-spec", filepath.Join("testdata", name+".yaml"), "-output", output}))
+			src, err := os.ReadFile(output)
+			require.NoError(t, err)
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, output, src, 0)
+			require.NoError(t, err)
+			conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+			_, err = conf.Check(name, fset, []*ast.File{file}, nil)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRun_DefaultOutput(t *testing.T) {
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "states.json")
+	spec := `{"enums":[{"name":"TestState","type":"string","members":[{"name":"Passed","value":"passed"}]}]}`
+	require.NoError(t, os.WriteFile(specPath, []byte(spec), 0o600))
+
+	require.NoError(t, run([]string{"-spec", specPath, "-package", "states"}))
+	got, err := os.ReadFile(filepath.Join(dir, "states_enum.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(got), "package states\n")
+	require.Contains(t, string(got), "func ParseTestState(val string) *TestState {")
+}
+
+func TestRun_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "missing spec",
+			args: []string{},
+		},
+		{
+			name: "unknown spec",
+			args: []string{"-spec", "testdata/unknown.yaml"},
+		},
+		{
+			name: "unknown flag",
+			args: []string{"-unknown"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, run(tt.args))
+		})
+	}
+}
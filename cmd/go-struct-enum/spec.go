@@ -0,0 +1,267 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// spec describes the enums to generate.
+// It is decoded from YAML or JSON.
+//
+// Example:
+//
+//	package: states
+//	enums:
+//	  - name: TestState
+//	    type: string
+//	    compare: true
+//	    default: Unknown
+//	    members:
+//	      - name: Unknown
+//	        value: ""
+//	      - name: Passed
+//	        value: passed
+//	        display_name: Passed
+//	        description: The test passed
+//	        aliases: [ok]
+type spec struct {
+	// The package of the generated file
+	Package string `yaml:"package"`
+	// The enums to generate
+	Enums []enumSpec `yaml:"enums"`
+}
+
+// enumSpec describes an enum type.
+type enumSpec struct {
+	// The name of the enum type
+	Name string `yaml:"name"`
+	// The underlying type: an integer type, float64, string or bool
+	Type string `yaml:"type"`
+	// The name of the set variable, the plural of Name by default
+	Set string `yaml:"set"`
+	// To generate the comparison methods
+	Compare bool `yaml:"compare"`
+	// The optional name of the default member
+	Default string `yaml:"default"`
+	// To parse string values case-insensitively
+	FoldCase bool `yaml:"fold_case"`
+	// To ignore the white spaces around string values
+	TrimSpace bool `yaml:"trim_space"`
+	// To parse the deprecated members as their replacement
+	ReplaceDeprecated bool `yaml:"replace_deprecated"`
+	// To check that no two members have the same rank
+	StrictOrder bool `yaml:"strict_order"`
+	// To leave the members mutable instead of frozen by Bind
+	Mutable bool `yaml:"mutable"`
+	// The ordered members
+	Members []memberSpec `yaml:"members"`
+}
+
+// memberSpec describes an enum member.
+type memberSpec struct {
+	// The name of the member appended to the enum name
+	Name string `yaml:"name"`
+	// The value of the member, converted to the underlying type by validate:
+	// int64 for signed integers, uint64 for unsigned integers,
+	// float64, string or bool
+	Value any `yaml:"value"`
+	// The optional display name of the member
+	DisplayName string `yaml:"display_name"`
+	// The optional description of the member
+	Description string `yaml:"description"`
+	// The optional alternative inputs parsed as the member
+	Aliases []string `yaml:"aliases"`
+	// To mark the member as deprecated
+	Deprecated bool `yaml:"deprecated"`
+	// The optional name of the member replacing the deprecated member
+	Replacement string `yaml:"replacement"`
+	// The optional explicit rank used to order the member
+	Rank *int `yaml:"rank"`
+}
+
+// parseSpec decodes and validates the YAML or JSON spec.
+// Defaults are applied to the optional fields.
+func parseSpec(data []byte, defaultPackage string) (*spec, error) {
+	var s spec
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+	if s.Package == "" {
+		s.Package = defaultPackage
+	}
+	if !token.IsIdentifier(s.Package) {
+		return nil, fmt.Errorf("invalid package name '%s'", s.Package)
+	}
+	if len(s.Enums) == 0 {
+		return nil, errors.New("no enum declared")
+	}
+	names := map[string]bool{}
+	for i := range s.Enums {
+		e := &s.Enums[i]
+		if e.Set == "" {
+			e.Set = e.Name + "s"
+		}
+		if err := e.validate(); err != nil {
+			return nil, err
+		}
+		for _, name := range e.identifiers() {
+			if names[name] {
+				return nil, fmt.Errorf("identifier '%s' declared twice", name)
+			}
+			names[name] = true
+		}
+	}
+	return &s, nil
+}
+
+// validate returns an error if the enum is invalid.
+func (e *enumSpec) validate() error {
+	if !token.IsIdentifier(e.Name) || !token.IsExported(e.Name) {
+		return fmt.Errorf("invalid enum name '%s'", e.Name)
+	}
+	if !token.IsIdentifier(e.Set) {
+		return fmt.Errorf("enum '%s': invalid set name '%s'", e.Name, e.Set)
+	}
+	if !slices.Contains(underlyingTypes, e.Type) {
+		return fmt.Errorf("enum '%s': invalid type '%s', must be one of %s", e.Name, e.Type, strings.Join(underlyingTypes, ", "))
+	}
+	if len(e.Members) == 0 {
+		return fmt.Errorf("enum '%s': no member declared", e.Name)
+	}
+	values := map[any]bool{}
+	for i := range e.Members {
+		m := &e.Members[i]
+		if !token.IsIdentifier(e.Name + m.Name) {
+			return fmt.Errorf("enum '%s': invalid member name '%s'", e.Name, m.Name)
+		}
+		if m.Value == nil {
+			return fmt.Errorf("enum '%s': member '%s' has an invalid value '%v'", e.Name, m.Name, m.Value)
+		}
+		value, ok := convertValue(e.Type, m.Value)
+		if !ok {
+			return fmt.Errorf("enum '%s': member '%s' value '%v' must be of type %s", e.Name, m.Name, m.Value, e.Type)
+		}
+		m.Value = value
+		if values[m.Value] {
+			return fmt.Errorf("enum '%s': value '%v' declared twice", e.Name, m.Value)
+		}
+		values[m.Value] = true
+	}
+	for _, m := range e.Members {
+		if m.Replacement != "" && (m.Replacement == m.Name || !e.hasMember(m.Replacement)) {
+			return fmt.Errorf("enum '%s': invalid replacement '%s' of member '%s'", e.Name, m.Replacement, m.Name)
+		}
+		for _, alias := range m.Aliases {
+			if values[alias] {
+				return fmt.Errorf("enum '%s': alias '%s' of member '%s' declared twice", e.Name, alias, m.Name)
+			}
+			values[alias] = true
+		}
+	}
+	if e.StrictOrder {
+		ranks := map[int]bool{}
+		for i, m := range e.Members {
+			rank := i
+			if m.Rank != nil {
+				rank = *m.Rank
+			}
+			if ranks[rank] {
+				return fmt.Errorf("enum '%s': rank %d of member '%s' declared twice", e.Name, rank, m.Name)
+			}
+			ranks[rank] = true
+		}
+	}
+	if e.Default != "" && !e.hasMember(e.Default) {
+		return fmt.Errorf("enum '%s': default member '%s' not declared", e.Name, e.Default)
+	}
+	return nil
+}
+
+// underlyingTypes are the supported underlying types of the enums.
+var underlyingTypes = []string{
+	"int", "int8", "int16", "int32", "int64",
+	"uint", "uint8", "uint16", "uint32", "uint64",
+	"float64", "string", "bool",
+}
+
+// convertValue converts the decoded value to the underlying type.
+// The boolean is false if the value is not of the type or overflows it.
+func convertValue(typ string, value any) (any, bool) {
+	switch typ {
+	case "string", "bool":
+		ok := fmt.Sprintf("%T", value) == typ
+		return value, ok
+	case "float64":
+		switch v := value.(type) {
+		case int:
+			return float64(v), true
+		case float64:
+			return v, !math.IsInf(v, 0) && !math.IsNaN(v)
+		}
+		return nil, false
+	}
+	// The YAML decoder returns int, or uint64 if the value overflows int
+	var text string
+	switch v := value.(type) {
+	case int:
+		text = strconv.Itoa(v)
+	case uint64:
+		text = strconv.FormatUint(v, 10)
+	default:
+		return nil, false
+	}
+	size := strconv.IntSize
+	if bits := strings.TrimLeft(typ, "uint"); bits != "" {
+		size, _ = strconv.Atoi(bits)
+	}
+	if strings.HasPrefix(typ, "uint") {
+		v, err := strconv.ParseUint(text, 10, size)
+		return v, err == nil
+	}
+	v, err := strconv.ParseInt(text, 10, size)
+	return v, err == nil
+}
+
+// hasMember returns true if a member has the given name.
+func (e *enumSpec) hasMember(name string) bool {
+	for _, m := range e.Members {
+		if m.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseOptions returns the parse options of the set.
+func (e *enumSpec) ParseOptions() []string {
+	var opts []string
+	if e.FoldCase {
+		opts = append(opts, "enum.FoldCase()")
+	}
+	if e.TrimSpace {
+		opts = append(opts, "enum.TrimSpace()")
+	}
+	if e.ReplaceDeprecated {
+		opts = append(opts, "enum.ReplaceDeprecated()")
+	}
+	return opts
+}
+
+// identifiers returns the package level identifiers declared for the enum.
+func (e *enumSpec) identifiers() []string {
+	names := []string{e.Name, e.Set, "Parse" + e.Name, "MustParse" + e.Name, "TryParse" + e.Name}
+	if e.Default != "" {
+		names = append(names, "Parse"+e.Name+"OrDefault")
+	}
+	for _, m := range e.Members {
+		names = append(names, e.Name+m.Name)
+	}
+	return names
+}
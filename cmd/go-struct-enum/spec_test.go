@@ -0,0 +1,246 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `
+enums:
+  - name: TestState
+    type: string
+    members:
+      - name: Passed
+        value: passed`,
+		},
+		{
+			name:    "invalid yaml",
+			data:    `enums: [`,
+			wantErr: "invalid spec",
+		},
+		{
+			name:    "invalid package",
+			data:    `package: "my-package"`,
+			wantErr: "invalid package name 'my-package'",
+		},
+		{
+			name:    "no enum",
+			data:    `package: states`,
+			wantErr: "no enum declared",
+		},
+		{
+			name: "unexported enum",
+			data: `
+enums:
+  - name: testState
+    type: string`,
+			wantErr: "invalid enum name 'testState'",
+		},
+		{
+			name: "invalid type",
+			data: `
+enums:
+  - name: TestState
+    type: complex128`,
+			wantErr: "invalid type 'complex128'",
+		},
+		{
+			name: "no member",
+			data: `
+enums:
+  - name: TestState
+    type: string`,
+			wantErr: "no member declared",
+		},
+		{
+			name: "invalid member name",
+			data: `
+enums:
+  - name: TestState
+    type: string
+    members:
+      - name: Is-Passed
+        value: passed`,
+			wantErr: "invalid member name 'Is-Passed'",
+		},
+		{
+			name: "int value for string enum",
+			data: `
+enums:
+  - name: TestState
+    type: string
+    members:
+      - name: Passed
+        value: 1`,
+			wantErr: "value '1' must be of type string",
+		},
+		{
+			name: "string value for int enum",
+			data: `
+enums:
+  - name: TestState
+    type: int
+    members:
+      - name: Passed
+        value: passed`,
+			wantErr: "value 'passed' must be of type int",
+		},
+		{
+			name: "missing value",
+			data: `
+enums:
+  - name: TestState
+    type: int
+    members:
+      - name: Passed`,
+			wantErr: "invalid value",
+		},
+		{
+			name: "overflowing value",
+			data: `
+enums:
+  - name: TestState
+    type: int8
+    members:
+      - name: Passed
+        value: 128`,
+			wantErr: "value '128' must be of type int8",
+		},
+		{
+			name: "negative unsigned value",
+			data: `
+enums:
+  - name: TestState
+    type: uint
+    members:
+      - name: Passed
+        value: -1`,
+			wantErr: "value '-1' must be of type uint",
+		},
+		{
+			name: "string value for bool enum",
+			data: `
+enums:
+  - name: TestState
+    type: bool
+    members:
+      - name: Passed
+        value: "true"`,
+			wantErr: "value 'true' must be of type bool",
+		},
+		{
+			name: "infinite float value",
+			data: `
+enums:
+  - name: TestState
+    type: float64
+    members:
+      - name: Passed
+        value: .inf`,
+			wantErr: "must be of type float64",
+		},
+		{
+			name: "duplicate value",
+			data: `
+enums:
+  - name: TestState
+    type: int
+    members:
+      - name: Passed
+        value: 1
+      - name: Failed
+        value: 1`,
+			wantErr: "value '1' declared twice",
+		},
+		{
+			name: "duplicate identifier",
+			data: `
+enums:
+  - name: TestState
+    type: int
+    members:
+      - name: Passed
+        value: 1
+  - name: TestStatePassed
+    type: int
+    members:
+      - name: Ok
+        value: 1`,
+			wantErr: "identifier 'TestStatePassed' declared twice",
+		},
+		{
+			name: "duplicate alias",
+			data: `
+enums:
+  - name: TestState
+    type: string
+    members:
+      - name: Passed
+        value: passed
+      - name: Failed
+        value: failed
+        aliases: [passed]`,
+			wantErr: "alias 'passed' of member 'Failed' declared twice",
+		},
+		{
+			name: "unknown replacement",
+			data: `
+enums:
+  - name: TestState
+    type: string
+    members:
+      - name: Ok
+        value: ok
+        replacement: Passed`,
+			wantErr: "invalid replacement 'Passed' of member 'Ok'",
+		},
+		{
+			name: "duplicate rank",
+			data: `
+enums:
+  - name: TestState
+    type: int
+    strict_order: true
+    members:
+      - name: Passed
+        value: 1
+        rank: 1
+      - name: Failed
+        value: 2`,
+			wantErr: "rank 1 of member 'Failed' declared twice",
+		},
+		{
+			name: "unknown default",
+			data: `
+enums:
+  - name: TestState
+    type: int
+    default: Unknown
+    members:
+      - name: Passed
+        value: 1`,
+			wantErr: "default member 'Unknown' not declared",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := parseSpec([]byte(tt.data), "states")
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "states", s.Package)
+			require.Equal(t, "TestStates", s.Enums[0].Set)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"sync"
+)
+
+// Codec encodes and decodes the underlying values of enums in a format,
+// such as MessagePack, CBOR or BSON.
+// The set resolves the decoded values to its members,
+// so a codec only converts the underlying values.
+//
+// Example:
+//
+//	type msgpackCodec struct{}
+//
+//	func (msgpackCodec) Name() string                       { return "msgpack" }
+//	func (msgpackCodec) Marshal(v any) ([]byte, error)      { return msgpack.Marshal(v) }
+//	func (msgpackCodec) Unmarshal(data []byte, v any) error { return msgpack.Unmarshal(data, v) }
+type Codec interface {
+	// Name returns the name of the format.
+	Name() string
+	// Marshal returns the encoding of the value.
+	// The value is an underlying value, or nil for a reference without member.
+	Marshal(v any) ([]byte, error)
+	// Unmarshal decodes the data into the value pointed to by v.
+	// The value is a pointer to a pointer to an underlying value,
+	// left nil if the data holds a null value.
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec is the reference Codec for the JSON format,
+// registered under the name "json".
+type JSONCodec struct{}
+
+// Name implements the Codec interface.
+func (JSONCodec) Name() string {
+	return "json"
+}
+
+// Marshal implements the Codec interface.
+func (JSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal implements the Codec interface.
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+var (
+	// Codecs registered for all the sets by name
+	codecs   = map[string]Codec{JSONCodec{}.Name(): JSONCodec{}}
+	codecsMu sync.RWMutex
+)
+
+// RegisterCodec registers the codec for all the sets under its name.
+// It panics if a codec is already registered under that name.
+//
+// Example:
+//
+//	func init() {
+//		enum.RegisterCodec(msgpackCodec{})
+//	}
+func RegisterCodec(c Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	if _, ok := codecs[c.Name()]; ok {
+		panic(fmt.Errorf("%w: '%s'", ErrCodecRegistered, c.Name()))
+	}
+	codecs[c.Name()] = c
+}
+
+// LookupCodec returns the codec registered under the given name.
+// It returns ErrUnknownCodec if no codec is registered under that name.
+func LookupCodec(name string) (Codec, error) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	c, ok := codecs[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnknownCodec, name)
+	}
+	return c, nil
+}
+
+// WithCodecs returns a copy of the set using the given codecs.
+// They take precedence over the codecs registered with RegisterCodec
+// under the same name.
+// It panics if the set is bound.
+func (s *Set[T]) WithCodecs(cs ...Codec) *Set[T] {
+	s.mustNotBeBound()
+	c := *s
+	c.codecs = maps.Clone(s.codecs)
+	if c.codecs == nil {
+		c.codecs = make(map[string]Codec, len(cs))
+	}
+	for _, codec := range cs {
+		c.codecs[codec.Name()] = codec
+	}
+	return &c
+}
+
+// Codec returns the codec of the set for the given format:
+// the codec given to WithCodecs, or else the registered codec.
+// It returns ErrUnknownCodec if the format has no codec.
+func (s *Set[T]) Codec(format string) (Codec, error) {
+	if c, ok := s.codecs[format]; ok {
+		return c, nil
+	}
+	return LookupCodec(format)
+}
+
+// Encode encodes the value of the member in the given format.
+// A nil member is encoded as the default member of the set.
+// It returns a NotInListError if the member is not in the set
+// and ErrUnknownCodec if the format has no codec.
+func (s *Set[T]) Encode(format string, e Enummer[T]) ([]byte, error) {
+	c, err := s.Codec(format)
+	if err != nil {
+		return nil, err
+	}
+	if isNilEnummer(e) && s.def != nil {
+		e = s.def
+	}
+	i, err := s.TryIndex(e)
+	if err != nil {
+		return nil, err
+	}
+	return c.Marshal(s.members[i].GetValue())
+}
+
+// Decode decodes the data in the given format into an Enummer.
+// Members are matched like TryParse, and a null value
+// is decoded as the default member of the set.
+// It returns an UnknownValueError if the value is not a member of the set
+// and ErrUnknownCodec if the format has no codec.
+func (s *Set[T]) Decode(format string, data []byte) (Enummer[T], error) {
+	val, err := s.decodeValue(format, data)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		if s.def != nil {
+			return s.def, nil
+		}
+		return nil, &UnknownValueError{Value: nil, Type: reflect.TypeOf(s.members[0])}
+	}
+	return s.TryParse(*val)
+}
+
+// DecodeOrDefault decodes the data in the given format into an Enummer.
+// If the value is not a member of the set, it returns the default member,
+// or an UnknownValueError if the set has no default member.
+func (s *Set[T]) DecodeOrDefault(format string, data []byte) (Enummer[T], error) {
+	e, err := s.Decode(format, data)
+	if errors.Is(err, ErrUnknownValue) && s.def != nil {
+		return s.def, nil
+	}
+	return e, err
+}
+
+// decodeValue decodes the underlying value of the data in the given format.
+// It returns nil if the data holds a null value.
+func (s *Set[T]) decodeValue(format string, data []byte) (*T, error) {
+	c, err := s.Codec(format)
+	if err != nil {
+		return nil, err
+	}
+	var val *T
+	if err := c.Unmarshal(data, &val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// encode implements the binding interface.
+func (s *Set[T]) encode(format string, member any) ([]byte, error) {
+	if member == nil {
+		c, err := s.Codec(format)
+		if err != nil {
+			return nil, err
+		}
+		return c.Marshal(nil)
+	}
+	e, ok := member.(Enummer[T])
+	if !ok {
+		return nil, &TypeMismatchError{A: reflect.TypeOf(member), B: reflect.TypeOf(s.members[0])}
+	}
+	return s.Encode(format, e)
+}
+
+// decode implements the binding interface.
+// It returns nil if the data holds a null value.
+func (s *Set[T]) decode(format string, data []byte) (any, error) {
+	val, err := s.decodeValue(format, data)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return s.TryParse(*val)
+}
+
+// Encode encodes the member of the reference in the given format
+// with the codec of the bound set.
+// A reference without member nor default is encoded as null.
+func (r Ref[E]) Encode(format string) ([]byte, error) {
+	b, err := bindingOf[E]()
+	if err != nil {
+		return nil, err
+	}
+	var zero E
+	member := r.Get()
+	if member == zero {
+		return b.encode(format, nil)
+	}
+	return b.encode(format, member)
+}
+
+// Decode decodes the data in the given format with the codec of the bound set.
+// A null value resets the reference to the zero value,
+// which resolves to the default member if the bound set has one.
+// It returns an UnknownValueError if the value is not a member of the bound set.
+func (r *Ref[E]) Decode(format string, data []byte) error {
+	return r.resolve(func(b binding) (any, error) {
+		member, err := b.decode(format, data)
+		if err == nil && member == nil {
+			var zero E
+			return zero, nil
+		}
+		return member, err
+	})
+}
@@ -0,0 +1,249 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// envelopeCodec encodes the values in a JSON object: {"v":value}
+type envelopeCodec struct {
+	name string
+}
+
+func (c envelopeCodec) Name() string {
+	return c.name
+}
+
+func (envelopeCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(struct {
+		V any `json:"v"`
+	}{v})
+}
+
+func (envelopeCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, &struct {
+		V any `json:"v"`
+	}{v})
+}
+
+func init() {
+	RegisterCodec(envelopeCodec{name: "envelope"})
+}
+
+func TestRegisterCodec(t *testing.T) {
+	c, err := LookupCodec("json")
+	require.NoError(t, err)
+	require.Equal(t, JSONCodec{}, c)
+
+	_, err = LookupCodec("xxx")
+	require.ErrorIs(t, err, ErrUnknownCodec)
+	require.EqualError(t, err, "enum: unknown codec: 'xxx'")
+
+	require.PanicsWithError(t, "enum: codec already registered: 'json'", func() {
+		RegisterCodec(envelopeCodec{name: "json"})
+	})
+}
+
+func TestSet_Encode(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     *Set[string]
+		format  string
+		member  Enummer[string]
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "json",
+			set:    TestRefs,
+			format: "json",
+			member: TestRefWorld,
+			want:   `"world"`,
+		},
+		{
+			name:   "registered codec",
+			set:    TestRefs,
+			format: "envelope",
+			member: TestRefHello,
+			want:   `{"v":"hello"}`,
+		},
+		{
+			name:   "set codec",
+			set:    NewSet[string](TestRefHello, TestRefWorld).WithCodecs(envelopeCodec{name: "json"}),
+			format: "json",
+			member: TestRefHello,
+			want:   `{"v":"hello"}`,
+		},
+		{
+			name:   "nil with default",
+			set:    TestRefDefaults,
+			format: "json",
+			member: nil,
+			want:   `"unknown"`,
+		},
+		{
+			name:    "nil without default",
+			set:     TestRefs,
+			format:  "json",
+			member:  nil,
+			wantErr: ErrNotInList,
+		},
+		{
+			name:    "not in set",
+			set:     TestRefs,
+			format:  "json",
+			member:  TestRefDefaultKnown,
+			wantErr: ErrTypeMismatch,
+		},
+		{
+			name:    "unknown codec",
+			set:     TestRefs,
+			format:  "xxx",
+			member:  TestRefHello,
+			wantErr: ErrUnknownCodec,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.set.Encode(tt.format, tt.member)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+
+	// The codecs of a copy do not change the set
+	got, err := TestRefs.Encode("json", TestRefHello)
+	require.NoError(t, err)
+	require.Equal(t, `"hello"`, string(got))
+}
+
+func TestSet_Decode(t *testing.T) {
+	tests := []struct {
+		name           string
+		set            *Set[string]
+		format         string
+		data           string
+		want           Enummer[string]
+		wantDefault    Enummer[string]
+		wantErr        error
+		wantDefaultErr error
+	}{
+		{
+			name:        "json",
+			set:         TestRefs,
+			format:      "json",
+			data:        `"world"`,
+			want:        TestRefWorld,
+			wantDefault: TestRefWorld,
+		},
+		{
+			name:        "registered codec",
+			set:         TestRefs,
+			format:      "envelope",
+			data:        `{"v":"hello"}`,
+			want:        TestRefHello,
+			wantDefault: TestRefHello,
+		},
+		{
+			name:        "null with default",
+			set:         TestRefDefaults,
+			format:      "envelope",
+			data:        `{"v":null}`,
+			want:        TestRefDefaultUnknown,
+			wantDefault: TestRefDefaultUnknown,
+		},
+		{
+			name:           "null without default",
+			set:            TestRefs,
+			format:         "json",
+			data:           `null`,
+			wantErr:        ErrUnknownValue,
+			wantDefaultErr: ErrUnknownValue,
+		},
+		{
+			name:        "unknown value with default",
+			set:         TestRefDefaults,
+			format:      "json",
+			data:        `"xxx"`,
+			wantErr:     ErrUnknownValue,
+			wantDefault: TestRefDefaultUnknown,
+		},
+		{
+			name:           "unknown value without default",
+			set:            TestRefs,
+			format:         "json",
+			data:           `"xxx"`,
+			wantErr:        ErrUnknownValue,
+			wantDefaultErr: ErrUnknownValue,
+		},
+		{
+			name:           "unknown codec",
+			set:            TestRefDefaults,
+			format:         "xxx",
+			data:           `"known"`,
+			wantErr:        ErrUnknownCodec,
+			wantDefaultErr: ErrUnknownCodec,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.set.Decode(tt.format, []byte(tt.data))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Same(t, tt.want, got)
+			}
+
+			got, err = tt.set.DecodeOrDefault(tt.format, []byte(tt.data))
+			if tt.wantDefaultErr != nil {
+				require.ErrorIs(t, err, tt.wantDefaultErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Same(t, tt.wantDefault, got)
+		})
+	}
+
+	_, err := TestRefInts.Decode("json", []byte(`"one"`))
+	require.Error(t, err)
+}
+
+func TestRef_Codec(t *testing.T) {
+	got, err := NewRef(TestRefTwo).Encode("envelope")
+	require.NoError(t, err)
+	require.Equal(t, `{"v":2}`, string(got))
+
+	got, err = Ref[*TestRefInt]{}.Encode("envelope")
+	require.NoError(t, err)
+	require.Equal(t, `{"v":null}`, string(got))
+
+	got, err = Ref[*TestRefDefault]{}.Encode("json")
+	require.NoError(t, err)
+	require.Equal(t, `"unknown"`, string(got))
+
+	_, err = NewRef(TestRefOne).Encode("xxx")
+	require.ErrorIs(t, err, ErrUnknownCodec)
+
+	r := NewRef(TestRefOne)
+	require.NoError(t, r.Decode("envelope", []byte(`{"v":2}`)))
+	require.Equal(t, NewRef(TestRefTwo), r)
+	require.NoError(t, r.Decode("envelope", []byte(`{"v":null}`)))
+	require.Equal(t, Ref[*TestRefInt]{}, r)
+	require.ErrorIs(t, r.Decode("envelope", []byte(`{"v":3}`)), ErrUnknownValue)
+
+	d := NewRef(TestRefDefaultKnown)
+	require.NoError(t, d.Decode("json", []byte(`null`)))
+	require.Same(t, TestRefDefaultUnknown, d.Get())
+
+	var unbound Ref[*TestRefUnbound]
+	_, err = unbound.Encode("json")
+	require.ErrorIs(t, err, ErrNotBound)
+	require.ErrorIs(t, unbound.Decode("json", []byte(`"hello"`)), ErrNotBound)
+}
@@ -0,0 +1,200 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// errUnsupportedType is wrapped by ConversionError
+// when the type of the value cannot be converted.
+var errUnsupportedType = errors.New("unsupported type")
+
+// scanValue converts a value returned by a database driver
+// into the enum underlying type. NULL returns the zero value.
+// It returns a ConversionError if the value cannot be converted or overflows.
+func scanValue[T Underlying](value interface{}) (T, error) {
+	var val T
+	if value == nil {
+		return val, nil
+	}
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+	rv := reflect.ValueOf(&val).Elem()
+	var err error
+	switch rv.Kind() {
+	case reflect.String:
+		err = convertString(rv, value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		err = convertInt(rv, value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		err = convertUint(rv, value)
+	case reflect.Float64:
+		err = convertFloat(rv, value)
+	case reflect.Bool:
+		err = convertBool(rv, value)
+	}
+	if err != nil {
+		return val, &ConversionError{Value: value, Type: rv.Type(), Err: err}
+	}
+	return val, nil
+}
+
+// convertString sets the string value from a string or []byte.
+func convertString(rv reflect.Value, value any) error {
+	switch v := value.(type) {
+	case string:
+		rv.SetString(v)
+	case []byte:
+		rv.SetString(string(v))
+	default:
+		return errUnsupportedType
+	}
+	return nil
+}
+
+// convertInt sets the signed integer value from an integer or a decimal text.
+func convertInt(rv reflect.Value, value any) error {
+	var i int64
+	switch v := value.(type) {
+	case int64:
+		i = v
+	case int32:
+		i = int64(v)
+	case int:
+		i = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return ErrOverflow
+		}
+		i = int64(v)
+	case string, []byte:
+		parsed, err := strconv.ParseInt(toString(v), 10, 64)
+		if err != nil {
+			return err
+		}
+		i = parsed
+	default:
+		return errUnsupportedType
+	}
+	if rv.OverflowInt(i) {
+		return ErrOverflow
+	}
+	rv.SetInt(i)
+	return nil
+}
+
+// convertUint sets the unsigned integer value from an integer or a decimal text.
+func convertUint(rv reflect.Value, value any) error {
+	var u uint64
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return ErrOverflow
+		}
+		u = uint64(v)
+	case int32:
+		if v < 0 {
+			return ErrOverflow
+		}
+		u = uint64(v)
+	case int:
+		if v < 0 {
+			return ErrOverflow
+		}
+		u = uint64(v)
+	case uint64:
+		u = v
+	case string, []byte:
+		parsed, err := strconv.ParseUint(toString(v), 10, 64)
+		if err != nil {
+			return err
+		}
+		u = parsed
+	default:
+		return errUnsupportedType
+	}
+	if rv.OverflowUint(u) {
+		return ErrOverflow
+	}
+	rv.SetUint(u)
+	return nil
+}
+
+// convertFloat sets the float value from a number or a decimal text.
+func convertFloat(rv reflect.Value, value any) error {
+	var f float64
+	switch v := value.(type) {
+	case float64:
+		f = v
+	case float32:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case string, []byte:
+		parsed, err := strconv.ParseFloat(toString(v), 64)
+		if err != nil {
+			return err
+		}
+		f = parsed
+	default:
+		return errUnsupportedType
+	}
+	rv.SetFloat(f)
+	return nil
+}
+
+// convertBool sets the bool value from a bool, an integer or a text.
+func convertBool(rv reflect.Value, value any) error {
+	var b bool
+	switch v := value.(type) {
+	case bool:
+		b = v
+	case int64:
+		b = v != 0
+	case string, []byte:
+		parsed, err := strconv.ParseBool(toString(v))
+		if err != nil {
+			return err
+		}
+		b = parsed
+	default:
+		return errUnsupportedType
+	}
+	rv.SetBool(b)
+	return nil
+}
+
+// toString returns the string of a string or []byte.
+func toString(value any) string {
+	if b, ok := value.([]byte); ok {
+		return string(b)
+	}
+	s, _ := value.(string)
+	return s
+}
+
+// driverValue converts the enum underlying value into a driver.Value:
+// integers to int64, floats to float64, bool and string as is.
+// It returns a ConversionError if an unsigned value overflows int64.
+func driverValue[T Underlying](val T) (driver.Value, error) {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return nil, &ConversionError{Value: val, Type: reflect.TypeOf(int64(0)), Err: ErrOverflow}
+		}
+		return int64(rv.Uint()), nil
+	case reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	default:
+		return rv.String(), nil
+	}
+}
@@ -0,0 +1,214 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test types with other underlying types
+type (
+	TestCode   uint8
+	TestWeight float64
+)
+
+func TestScanValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		scan    func(any) (any, error)
+		value   any
+		want    any
+		wantErr error
+	}{
+		{
+			name:  "int8 from int64",
+			scan:  scanAny[int8],
+			value: int64(-12),
+			want:  int8(-12),
+		},
+		{
+			name:    "int8 overflow",
+			scan:    scanAny[int8],
+			value:   int64(128),
+			wantErr: ErrOverflow,
+		},
+		{
+			name:  "int32 from text",
+			scan:  scanAny[int32],
+			value: []byte("-42"),
+			want:  int32(-42),
+		},
+		{
+			name:  "int64 from uint64",
+			scan:  scanAny[int64],
+			value: uint64(42),
+			want:  int64(42),
+		},
+		{
+			name:    "int64 overflow from uint64",
+			scan:    scanAny[int64],
+			value:   uint64(math.MaxUint64),
+			wantErr: ErrOverflow,
+		},
+		{
+			name:  "rune from int64",
+			scan:  scanAny[rune],
+			value: int64('a'),
+			want:  'a',
+		},
+		{
+			name:  "named uint8 from int64",
+			scan:  scanAny[TestCode],
+			value: int64(255),
+			want:  TestCode(255),
+		},
+		{
+			name:    "uint8 overflow",
+			scan:    scanAny[TestCode],
+			value:   int64(256),
+			wantErr: ErrOverflow,
+		},
+		{
+			name:    "uint8 negative",
+			scan:    scanAny[TestCode],
+			value:   int64(-1),
+			wantErr: ErrOverflow,
+		},
+		{
+			name:  "uint64 from text",
+			scan:  scanAny[uint64],
+			value: "18446744073709551615",
+			want:  uint64(math.MaxUint64),
+		},
+		{
+			name:    "uint from float64",
+			scan:    scanAny[uint],
+			value:   1.5,
+			wantErr: ErrConversion,
+		},
+		{
+			name:  "float64 from float64",
+			scan:  scanAny[TestWeight],
+			value: 1.5,
+			want:  TestWeight(1.5),
+		},
+		{
+			name:  "float64 from int64",
+			scan:  scanAny[float64],
+			value: int64(2),
+			want:  2.0,
+		},
+		{
+			name:  "float64 from text",
+			scan:  scanAny[float64],
+			value: []byte("2.5"),
+			want:  2.5,
+		},
+		{
+			name:    "float64 from invalid text",
+			scan:    scanAny[float64],
+			value:   "hello",
+			wantErr: ErrConversion,
+		},
+		{
+			name:  "bool from bool",
+			scan:  scanAny[bool],
+			value: true,
+			want:  true,
+		},
+		{
+			name:  "bool from int64",
+			scan:  scanAny[bool],
+			value: int64(1),
+			want:  true,
+		},
+		{
+			name:  "bool from text",
+			scan:  scanAny[bool],
+			value: []byte("false"),
+			want:  false,
+		},
+		{
+			name:  "bool from null",
+			scan:  scanAny[bool],
+			value: nil,
+			want:  false,
+		},
+		{
+			name:    "bool from float64",
+			scan:    scanAny[bool],
+			value:   1.0,
+			wantErr: ErrConversion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.scan(tt.value)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// scanAny calls scanValue and returns the result as any.
+func scanAny[T Underlying](value any) (any, error) {
+	return scanValue[T](value)
+}
+
+func TestDriverValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   func() (driver.Value, error)
+		want    driver.Value
+		wantErr error
+	}{
+		{
+			name:  "int8",
+			value: New(int8(-12)).Value,
+			want:  int64(-12),
+		},
+		{
+			name:  "named uint8",
+			value: New(TestCode(255)).Value,
+			want:  int64(255),
+		},
+		{
+			name:    "uint64 overflow",
+			value:   New(uint64(math.MaxUint64)).Value,
+			wantErr: ErrOverflow,
+		},
+		{
+			name:  "named float64",
+			value: New(TestWeight(1.5)).Value,
+			want:  1.5,
+		},
+		{
+			name:  "bool",
+			value: New(true).Value,
+			want:  true,
+		},
+		{
+			name:  "string",
+			value: New("hello").Value,
+			want:  "hello",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value()
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.True(t, driver.IsValue(got))
+		})
+	}
+}
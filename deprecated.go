@@ -0,0 +1,81 @@
+package enum
+
+import "reflect"
+
+// WithDeprecationHook returns a copy of the set calling the hook
+// each time a deprecated member is parsed, for logging or metrics.
+// The replacement is nil if the deprecated member has no replacement.
+//
+// Example:
+//
+//	TestStates = enum.NewSet[string](
+//		TestStatePassed,
+//		TestStateOk,
+//	).WithParseOptions(enum.ReplaceDeprecated()).
+//		WithDeprecationHook(func(deprecated, replacement enum.Enummer[string]) {
+//			log.Printf("deprecated state %v", deprecated)
+//		}).Bind()
+func (s *Set[T]) WithDeprecationHook(hook func(deprecated, replacement Enummer[T])) *Set[T] {
+	s.mustNotBeBound()
+	c := *s
+	c.hook = hook
+	return &c
+}
+
+// IsDeprecated returns true if the member of the set is deprecated.
+func (s *Set[T]) IsDeprecated(e Enummer[T]) bool {
+	if isNilEnummer(e) {
+		return false
+	}
+	_, ok := s.deprecated[e.GetValue()]
+	return ok && s.Contains(e)
+}
+
+// buildDeprecated indexes the replacements of the deprecated members.
+// It returns an error if a replacement is not a member of the set.
+func (s *Set[T]) buildDeprecated() error {
+	s.deprecated = make(map[T]Enummer[T])
+	for _, e := range s.members {
+		d, ok := e.(interface {
+			Deprecated() bool
+			Replacement() any
+		})
+		if !ok || !d.Deprecated() {
+			continue
+		}
+		var replacement Enummer[T]
+		if r := d.Replacement(); r != nil {
+			typed, ok := r.(Enummer[T])
+			if !ok {
+				return &TypeMismatchError{A: reflect.TypeOf(r), B: getEnummerType(e)}
+			}
+			pos, err := s.index.position(typed)
+			if err != nil {
+				return err
+			}
+			replacement = s.members[pos]
+		}
+		s.deprecated[e.GetValue()] = replacement
+	}
+	return nil
+}
+
+// deprecate returns the member to parse for the given member.
+// The hook is called for deprecated members, which are replaced
+// if the set has the ReplaceDeprecated parse option.
+func (s *Set[T]) deprecate(e Enummer[T]) Enummer[T] {
+	if e == nil || len(s.deprecated) == 0 {
+		return e
+	}
+	replacement, ok := s.deprecated[e.GetValue()]
+	if !ok {
+		return e
+	}
+	if s.hook != nil {
+		s.hook(e, replacement)
+	}
+	if s.config.replaceDeprecated && replacement != nil {
+		return replacement
+	}
+	return e
+}
@@ -0,0 +1,85 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test type with deprecated members
+type TestDeprecatedState struct {
+	Enum[string]
+}
+
+var (
+	TestDeprecatedStatePassed = &TestDeprecatedState{New("passed")}
+	TestDeprecatedStateOk     = &TestDeprecatedState{New("ok", WithReplacement(TestDeprecatedStatePassed))}
+	TestDeprecatedStateLegacy = &TestDeprecatedState{New("legacy", WithDeprecated())}
+)
+
+// Hook calls recorded by the bound set
+var testDeprecatedCalls [][2]Enummer[string]
+
+var TestDeprecatedStates = NewSet[string](
+	TestDeprecatedStatePassed,
+	TestDeprecatedStateOk,
+	TestDeprecatedStateLegacy,
+).WithParseOptions(ReplaceDeprecated()).
+	WithDeprecationHook(func(deprecated, replacement Enummer[string]) {
+		testDeprecatedCalls = append(testDeprecatedCalls, [2]Enummer[string]{deprecated, replacement})
+	}).Bind()
+
+func TestEnum_Deprecated(t *testing.T) {
+	require.False(t, TestDeprecatedStatePassed.Deprecated())
+	require.Nil(t, TestDeprecatedStatePassed.Replacement())
+	require.True(t, TestDeprecatedStateOk.Deprecated())
+	require.Same(t, TestDeprecatedStatePassed, TestDeprecatedStateOk.Replacement())
+	require.True(t, TestDeprecatedStateLegacy.Deprecated())
+	require.Nil(t, TestDeprecatedStateLegacy.Replacement())
+}
+
+func TestSet_Deprecated(t *testing.T) {
+	testDeprecatedCalls = nil
+
+	require.Equal(t, []Enummer[string]{TestDeprecatedStatePassed}, TestDeprecatedStates.Members())
+	require.Equal(t, []Enummer[string]{
+		TestDeprecatedStatePassed, TestDeprecatedStateOk, TestDeprecatedStateLegacy,
+	}, TestDeprecatedStates.AllMembers())
+	require.True(t, TestDeprecatedStates.IsDeprecated(TestDeprecatedStateOk))
+	require.False(t, TestDeprecatedStates.IsDeprecated(TestDeprecatedStatePassed))
+	require.False(t, TestDeprecatedStates.IsDeprecated(nil))
+
+	// Replaced and accepted members call the hook
+	require.Same(t, TestDeprecatedStatePassed, TestDeprecatedStates.Parse("passed"))
+	require.Same(t, TestDeprecatedStatePassed, TestDeprecatedStates.Parse("ok"))
+	require.Same(t, TestDeprecatedStateLegacy, TestDeprecatedStates.Parse("legacy"))
+	require.Equal(t, [][2]Enummer[string]{
+		{TestDeprecatedStateOk, TestDeprecatedStatePassed},
+		{TestDeprecatedStateLegacy, nil},
+	}, testDeprecatedCalls)
+
+	// Decoding goes through the bound set
+	var ref Ref[*TestDeprecatedState]
+	require.NoError(t, json.Unmarshal([]byte("\"ok\""), &ref))
+	require.Same(t, TestDeprecatedStatePassed, ref.Get())
+	require.NoError(t, ref.Scan([]byte("ok")))
+	require.Same(t, TestDeprecatedStatePassed, ref.Get())
+	require.Len(t, testDeprecatedCalls, 4)
+
+	// Without options, deprecated members are accepted
+	set := NewSet[string](TestDeprecatedStatePassed, TestDeprecatedStateOk)
+	require.Same(t, TestDeprecatedStateOk, set.Parse("ok"))
+	require.Equal(t, []Enummer[string]{TestDeprecatedStatePassed}, set.Members())
+}
+
+func TestNewSet_Replacement(t *testing.T) {
+	passed := &TestTypeString{New("passed")}
+
+	_, err := TryNewSet[string](passed, &TestTypeString{New("ok", WithReplacement(&TestTypeString{New("foo")}))})
+	require.ErrorIs(t, err, ErrNotInList)
+	_, err = TryNewSet[string](passed, &TestTypeString{New("ok", WithReplacement(TestRefHello))})
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	_, err = TryNewSet[string](passed, &TestTypeString{New("ok", WithReplacement(1))})
+	require.ErrorIs(t, err, ErrTypeMismatch)
+}
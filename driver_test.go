@@ -0,0 +1,202 @@
+package enum
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test type with a bound int enum
+type TestDriverInt struct {
+	Enum[int]
+}
+
+var (
+	TestDriverOne = &TestDriverInt{Enum[int]{val: 1}}
+	TestDriverTwo = &TestDriverInt{Enum[int]{val: 2}}
+	TestDrivers   = NewSet[int](TestDriverOne, TestDriverTwo).Bind()
+)
+
+// testDriverValues are the values returned by the test driver.
+// The query is the key of the value to return.
+var testDriverValues = map[string]driver.Value{
+	"int64":   int64(2),
+	"unknown": int64(3),
+	"bytes":   []byte("hello"),
+	"text":    "2",
+	"null":    nil,
+}
+
+func init() {
+	sql.Register("enum", testDriver{})
+}
+
+// testDriver is a fake database driver returning
+// a single row with the value matching the query.
+type testDriver struct{}
+
+func (testDriver) Open(string) (driver.Conn, error) {
+	return testConn{}, nil
+}
+
+type testConn struct{}
+
+func (testConn) Prepare(query string) (driver.Stmt, error) {
+	return testStmt{query}, nil
+}
+
+func (testConn) Close() error {
+	return nil
+}
+
+func (testConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+type testStmt struct {
+	query string
+}
+
+func (testStmt) Close() error {
+	return nil
+}
+
+func (testStmt) NumInput() int {
+	return -1
+}
+
+func (testStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+
+func (s testStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &testRows{value: testDriverValues[s.query]}, nil
+}
+
+type testRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (*testRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (*testRows) Close() error {
+	return nil
+}
+
+func (r *testRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	dest[0] = r.value
+	r.done = true
+	return nil
+}
+
+func TestEnum_ScanDriver(t *testing.T) {
+	db, err := sql.Open("enum", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	tests := []struct {
+		name  string
+		query string
+		dest  interface{ Scan(interface{}) error }
+		want  any
+	}{
+		{
+			name:  "int",
+			query: "int64",
+			dest:  &Enum[int]{},
+			want:  &Enum[int]{val: 2},
+		},
+		{
+			name:  "int from text",
+			query: "text",
+			dest:  &Enum[int]{},
+			want:  &Enum[int]{val: 2},
+		},
+		{
+			name:  "string",
+			query: "bytes",
+			dest:  &TestTypeString{},
+			want:  &TestTypeString{Enum[string]{val: "hello"}},
+		},
+		{
+			name:  "null",
+			query: "null",
+			dest:  &Enum[int]{val: 3},
+			want:  &Enum[int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, db.QueryRow(tt.query).Scan(tt.dest))
+			require.Equal(t, tt.want, tt.dest)
+		})
+	}
+}
+
+func TestRef_ScanDriver(t *testing.T) {
+	db, err := sql.Open("enum", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		query   string
+		want    *TestDriverInt
+		wantErr error
+	}{
+		{
+			name:  "int",
+			query: "int64",
+			want:  TestDriverTwo,
+		},
+		{
+			name:  "text",
+			query: "text",
+			want:  TestDriverTwo,
+		},
+		{
+			name:  "null",
+			query: "null",
+			want:  nil,
+		},
+		{
+			name:    "unknown",
+			query:   "unknown",
+			wantErr: ErrUnknownValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRef(TestDriverOne)
+			err := db.QueryRow(tt.query).Scan(&got)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Same(t, tt.want, got.Get())
+		})
+	}
+
+	// Canonical members must not be modified
+	require.Equal(t, 1, TestDriverOne.GetValue())
+}
+
+func TestRef_Value(t *testing.T) {
+	value, err := NewRef(TestDriverTwo).Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), value)
+
+	value, err = Ref[*TestDriverInt]{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
@@ -5,18 +5,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
-	"slices"
 )
 
-// Enummer is an interface that represents an enum.
+// Underlying is the constraint of the enum underlying types.
 // Type ~int is the set of all types whose underlying type is int.
 // Type ~string is the set of all types whose underlying type is string.
-type Enummer[T ~int | ~string] interface {
+// The same applies to the other integer types, float64 and bool.
+type Underlying interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float64 | ~string | ~bool
+}
+
+// Enummer is an interface that represents an enum.
+type Enummer[T Underlying] interface {
 	String() string
 	GetValue() T
 	EqualValue(other T) bool
 	MarshalJSON() ([]byte, error)
 	UnmarshalJSON(data []byte) error
+	MarshalText() ([]byte, error)
+	UnmarshalText(text []byte) error
 	Scan(value interface{}) error
 	Value() (driver.Value, error)
 }
@@ -25,12 +34,18 @@ type Enummer[T ~int | ~string] interface {
 var _ Enummer[int] = (*Enum[int])(nil)
 
 // Enum is a generic type used to create enums.
-type Enum[T ~int | ~string] struct {
+type Enum[T Underlying] struct {
 	// The value of the enum
 	val T
+	// The optional metadata of the enum
+	meta *metadata
+	// The frozen state of the member, nil if not frozen
+	frozen *frozenState[T]
 }
 
 // New creates a new enum with the given value.
+// Options set the optional metadata of the enum
+// such as its name, description and attributes.
 // The result must be embedded into a struct.
 // The embedding struct must be a pointer to
 // implement the Enummer interface.
@@ -41,8 +56,9 @@ type Enum[T ~int | ~string] struct {
 //		enum.Enum[string]
 //	}
 //	TestStatePassed = &TestState{enum.New("passed")}
-func New[T ~int | ~string](val T) Enum[T] {
-	return Enum[T]{val}
+//	TestStateFailed = &TestState{enum.New("failed", enum.WithName("Failed"))}
+func New[T Underlying](val T, opts ...Option) Enum[T] {
+	return Enum[T]{val: val, meta: newMetadata(opts)}
 }
 
 // GetValue returns the enum underlying value.
@@ -50,8 +66,12 @@ func (e Enum[T]) GetValue() T {
 	return e.val
 }
 
-// String returns the string representation of the enum underlying value.
+// String returns the display name of the enum if set,
+// otherwise the string representation of the enum underlying value.
 func (e Enum[T]) String() string {
+	if name := e.Name(); name != "" {
+		return name
+	}
 	return fmt.Sprintf("%v", e.val)
 }
 
@@ -66,24 +86,65 @@ func (e Enum[T]) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
+// It returns a FrozenError if the enum is a frozen member
+// and the data holds another value.
 func (e *Enum[T]) UnmarshalJSON(data []byte) error {
-	return json.Unmarshal(data, &e.val)
+	val := e.val
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	return e.set(val)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The enum is encoded as the text of its value, such as the decimal value
+// of int enums, so that it round-trips through UnmarshalText.
+// Ref, Flags and SetOf encode int members as their name if set.
+func (e Enum[T]) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%v", e.val)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Int enums are decoded from their decimal value:
+// use a Ref to decode them from their name.
+// It returns a FrozenError if the enum is a frozen member
+// and the text holds another value.
+func (e *Enum[T]) UnmarshalText(text []byte) error {
+	val, err := scanValue[T](string(text))
+	if err != nil {
+		return err
+	}
+	return e.set(val)
+}
+
+// nameOrValue returns the name of int enums if set,
+// otherwise the text of the value.
+func (e Enum[T]) nameOrValue() string {
+	if name := e.Name(); name != "" && reflect.TypeOf(e.val).Kind() != reflect.String {
+		return name
+	}
+	return fmt.Sprintf("%v", e.val)
 }
 
 // Scan implements the sql.Scanner interface.
+// It converts the types returned by database drivers:
+// integers and floats for numeric enums, bool and integers for bool enums,
+// []byte and string for all kinds. NULL resets the enum to its zero value.
+// It returns a FrozenError if the enum is a frozen member
+// and the value is another value.
 func (e *Enum[T]) Scan(value interface{}) error {
-	switch v := value.(type) {
-	case T:
-		e.val = v
-	default:
-		return fmt.Errorf("enum: cannot convert '%T' to '%T'", value, e)
+	val, err := scanValue[T](value)
+	if err != nil {
+		return err
 	}
-	return nil
+	return e.set(val)
 }
 
 // Value implements the driver.Valuer interface.
+// Integers are converted to int64 and floats to float64.
+// It returns a ConversionError if an unsigned value overflows int64.
 func (e Enum[T]) Value() (driver.Value, error) {
-	return e.val, nil
+	return driverValue(e.val)
 }
 
 // Parse parses the given string/int into an Enummer.
@@ -91,145 +152,190 @@ func (e Enum[T]) Value() (driver.Value, error) {
 // that takes the given string/int and returns the Enummer.
 // It panics if the Enummer in list are not of the same type
 // or if the list is empty. If the string/int is not found, it returns nil.
-func Parse[T ~int | ~string](list []Enummer[T]) func(T) Enummer[T] {
-	checkEnummerListType(list)
-	return func(val T) Enummer[T] {
-		for _, e := range list {
-			if e.EqualValue(val) {
-				return e
-			}
-		}
-		return nil
-	}
+func Parse[T Underlying](list []Enummer[T]) func(T) Enummer[T] {
+	mustCheckEnummerListType(list)
+	return newValueIndex(list).parse
 }
 
 // MustParse parses the given string/int into an Enummer.
 // It takes an Enummer list and returns a function
 // that takes the given string/int and returns the Enummer.
 // It panics if the Enummer in list are not of the same type
-// or if the list is empty. If the string/int is not found, it panics.
-func MustParse[T ~int | ~string](list []Enummer[T]) func(T) Enummer[T] {
-	checkEnummerListType(list)
+// or if the list is empty. If the string/int is not found,
+// it panics with an UnknownValueError.
+func MustParse[T Underlying](list []Enummer[T]) func(T) Enummer[T] {
+	mustCheckEnummerListType(list)
+	ix := newValueIndex(list)
 	return func(val T) Enummer[T] {
-		for _, e := range list {
-			if e.EqualValue(val) {
-				return e
-			}
+		e, err := ix.tryParse(val)
+		if err != nil {
+			panic(err)
 		}
-		panic(fmt.Sprintf("enum: '%v' not found", val))
+		return e
 	}
 }
 
+// TryParse parses the given string/int into an Enummer.
+// It takes an Enummer list and returns a function
+// that takes the given string/int and returns the Enummer.
+// The function returns an error if the Enummer in list are not
+// of the same type or if the list is empty.
+// If the string/int is not found, it returns an UnknownValueError.
+func TryParse[T Underlying](list []Enummer[T]) func(T) (Enummer[T], error) {
+	if err := checkEnummerListType(list); err != nil {
+		return func(T) (Enummer[T], error) {
+			return nil, err
+		}
+	}
+	return newValueIndex(list).tryParse
+}
+
 // Equal returns true if the first Enummer is equal to the second Enummer.
 // It panics if the Enummer are not of the same type.
-func Equal[T ~int | ~string](a, b Enummer[T]) bool {
+func Equal[T Underlying](a, b Enummer[T]) bool {
+	equal, err := TryEqual(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return equal
+}
+
+// TryEqual returns true if the first Enummer is equal to the second Enummer.
+// A nil Enummer is only equal to a nil Enummer.
+// It returns a TypeMismatchError if the Enummer are not of the same type.
+func TryEqual[T Underlying](a, b Enummer[T]) (bool, error) {
+	// Enummers of the same dynamic type are checked with reflect.TypeOf
+	// and isNilEnummer, without allocation
+	if ta := reflect.TypeOf(a); ta != nil && ta == reflect.TypeOf(b) {
+		if aNil, bNil := isNilEnummer(a), isNilEnummer(b); aNil || bNil {
+			return aNil && bNil, nil
+		}
+		return a.EqualValue(b.GetValue()), nil
+	}
 	if !compareEnummerType(a, b) {
-		panic(fmt.Sprintf("enum: different types '%T' and '%T'", a, b))
+		return false, &TypeMismatchError{A: getEnummerType(a), B: getEnummerType(b)}
 	}
-	return a.EqualValue(b.GetValue())
+	if aNil, bNil := isNilEnummer(a), isNilEnummer(b); aNil || bNil {
+		return aNil && bNil, nil
+	}
+	return a.EqualValue(b.GetValue()), nil
 }
 
 // GreaterThan returns true if the first Enummer is greater than the second Enummer.
 // It takes the Enummer list that defines the order and returns a function.
-// Higher indices are considered higher than lower indices.
+// Members are ordered by rank, then the members without rank by index.
 // It panics if Enummers are not of the same type or if Enummers are not in the list.
-func GreaterThan[T ~int | ~string](list []Enummer[T]) func(Enummer[T], Enummer[T]) bool {
-	checkEnummerListType(list)
-	return func(a, b Enummer[T]) bool {
-		// Get list index for values
-		ai := compareGetIndex(list, a)
-		bi := compareGetIndex(list, b)
-		// Compare indexes
-		return ai > bi
-	}
+func GreaterThan[T Underlying](list []Enummer[T]) func(Enummer[T], Enummer[T]) bool {
+	return mustCompare(list, func(ai, bi int) bool { return ai > bi })
+}
+
+// TryGreaterThan is like GreaterThan but the returned function
+// returns an error instead of panicking.
+func TryGreaterThan[T Underlying](list []Enummer[T]) func(Enummer[T], Enummer[T]) (bool, error) {
+	return tryCompare(list, func(ai, bi int) bool { return ai > bi })
 }
 
 // GreaterThanOrEqual returns true if the first Enummer is greater than or equal to the second Enummer.
 // It takes the Enummer list that defines the order and returns a function.
-// Higher indices are considered higher than lower indices.
+// Members are ordered by rank, then the members without rank by index.
 // It panics if Enummers are not of the same type or if Enummers are not in the list.
-func GreaterThanOrEqual[T ~int | ~string](list []Enummer[T]) func(Enummer[T], Enummer[T]) bool {
-	checkEnummerListType(list)
-	return func(a, b Enummer[T]) bool {
-		// Get list index for values
-		ai := compareGetIndex(list, a)
-		bi := compareGetIndex(list, b)
-		// Compare indexes
-		return ai >= bi
-	}
+func GreaterThanOrEqual[T Underlying](list []Enummer[T]) func(Enummer[T], Enummer[T]) bool {
+	return mustCompare(list, func(ai, bi int) bool { return ai >= bi })
+}
+
+// TryGreaterThanOrEqual is like GreaterThanOrEqual but the returned function
+// returns an error instead of panicking.
+func TryGreaterThanOrEqual[T Underlying](list []Enummer[T]) func(Enummer[T], Enummer[T]) (bool, error) {
+	return tryCompare(list, func(ai, bi int) bool { return ai >= bi })
 }
 
 // LessThan returns true if the first Enummer is less than the second Enummer.
 // It takes the Enummer list that defines the order and returns a function.
-// Higher indices are considered higher than lower indices.
+// Members are ordered by rank, then the members without rank by index.
 // It panics if Enummers are not of the same type or if Enummers are not in the list.
-func LessThan[T ~int | ~string](list []Enummer[T]) func(Enummer[T], Enummer[T]) bool {
-	checkEnummerListType(list)
-	return func(a, b Enummer[T]) bool {
-		// Get list index for values
-		ai := compareGetIndex(list, a)
-		bi := compareGetIndex(list, b)
-		// Compare indexes
-		return ai < bi
-	}
+func LessThan[T Underlying](list []Enummer[T]) func(Enummer[T], Enummer[T]) bool {
+	return mustCompare(list, func(ai, bi int) bool { return ai < bi })
+}
+
+// TryLessThan is like LessThan but the returned function
+// returns an error instead of panicking.
+func TryLessThan[T Underlying](list []Enummer[T]) func(Enummer[T], Enummer[T]) (bool, error) {
+	return tryCompare(list, func(ai, bi int) bool { return ai < bi })
 }
 
 // LessThanOrEqual returns true if the first Enummer is less than or equal to the second Enummer.
 // It takes the Enummer list that defines the order and returns a function.
-// Higher indices are considered higher than lower indices.
+// Members are ordered by rank, then the members without rank by index.
 // It panics if Enummers are not of the same type or if Enummers are not in the list.
-func LessThanOrEqual[T ~int | ~string](list []Enummer[T]) func(Enummer[T], Enummer[T]) bool {
-	checkEnummerListType(list)
-	return func(a, b Enummer[T]) bool {
-		// Get list index for values
-		ai := compareGetIndex(list, a)
-		bi := compareGetIndex(list, b)
-		// Compare indexes
-		return ai <= bi
+func LessThanOrEqual[T Underlying](list []Enummer[T]) func(Enummer[T], Enummer[T]) bool {
+	return mustCompare(list, func(ai, bi int) bool { return ai <= bi })
+}
+
+// TryLessThanOrEqual is like LessThanOrEqual but the returned function
+// returns an error instead of panicking.
+func TryLessThanOrEqual[T Underlying](list []Enummer[T]) func(Enummer[T], Enummer[T]) (bool, error) {
+	return tryCompare(list, func(ai, bi int) bool { return ai <= bi })
+}
+
+// tryCompare returns a function comparing the list indexes of two Enummers.
+// The function returns an error if the list is invalid,
+// if Enummers are not of the same type or if Enummers are not in the list.
+func tryCompare[T Underlying](
+	list []Enummer[T], cmp func(ai, bi int) bool,
+) func(Enummer[T], Enummer[T]) (bool, error) {
+	if err := checkEnummerListType(list); err != nil {
+		return func(Enummer[T], Enummer[T]) (bool, error) {
+			return false, err
+		}
+	}
+	ix := newValueIndex(list)
+	return func(a, b Enummer[T]) (bool, error) {
+		return ix.compare(a, b, cmp)
 	}
 }
 
-// compareGetIndex returns the index of the Enummer in the list.
-// It panics if the Enummer is not in the list.
-func compareGetIndex[T ~int | ~string](list []Enummer[T], e Enummer[T]) int {
-	// Check if e is in the list
-	if !existInEnummerList(list, e) {
-		panic(fmt.Sprintf("enum: '%v' not found in list", e))
+// mustCompare returns a function comparing the list indexes of two Enummers.
+// It panics if the list is invalid and the function panics
+// if Enummers are not of the same type or if Enummers are not in the list.
+func mustCompare[T Underlying](
+	list []Enummer[T], cmp func(ai, bi int) bool,
+) func(Enummer[T], Enummer[T]) bool {
+	mustCheckEnummerListType(list)
+	ix := newValueIndex(list)
+	return func(a, b Enummer[T]) bool {
+		result, err := ix.compare(a, b, cmp)
+		if err != nil {
+			panic(err)
+		}
+		return result
 	}
-	// Get list index for value
-	return slices.IndexFunc(list, func(elem Enummer[T]) bool {
-		return Equal(e, elem)
-	})
 }
 
-// checkEnummerListType panics if the list is empty or
+// checkEnummerListType returns an error if the list is empty or
 // if the Enummer in the list have different types.
-func checkEnummerListType[T ~int | ~string](list []Enummer[T]) {
+func checkEnummerListType[T Underlying](list []Enummer[T]) error {
 	if len(list) == 0 {
-		panic("enum: list is empty")
-	}
-	for i, e := range list {
-		for j, other := range list {
-			// Check the type of the Enummer using reflection
-			if i != j && !compareEnummerType(e, other) {
-				panic(fmt.Sprintf("enum: different types '%T' and '%T'", e, other))
-			}
+		return ErrEmptyList
+	}
+	for _, e := range list[1:] {
+		// Check the type of the Enummer using reflection
+		if !compareEnummerType(list[0], e) {
+			return &TypeMismatchError{A: getEnummerType(list[0]), B: getEnummerType(e)}
 		}
 	}
+	return nil
 }
 
-// existInEnummerList returns false if the Enummer is not in the list.
-func existInEnummerList[T ~int | ~string](list []Enummer[T], e Enummer[T]) bool {
-	for _, other := range list {
-		if Equal(other, e) {
-			return true
-		}
+// mustCheckEnummerListType panics if the list is empty or
+// if the Enummer in the list have different types.
+func mustCheckEnummerListType[T Underlying](list []Enummer[T]) {
+	if err := checkEnummerListType(list); err != nil {
+		panic(err)
 	}
-	return false
 }
 
 // compareEnummerType returns true if the Enummer are of the same type.
-func compareEnummerType[T ~int | ~string](a, b Enummer[T]) bool {
+func compareEnummerType[T Underlying](a, b Enummer[T]) bool {
 	return getEnummerType(a) == getEnummerType(b)
 }
 
@@ -243,13 +349,23 @@ func compareEnummerType[T ~int | ~string](a, b Enummer[T]) bool {
 //	type TestInt struct {
 //		*Enum[int]
 //	}
-//	getEnummerType(&Enum[int]{1}) // returns Enum[int]
-//	getEnummerType(TestInt{&Enum[int]{1}}) // returns TestInt
-//	getEnummerType(&TestInt{&Enum[int]{1}}) // returns TestInt
-func getEnummerType[T ~int | ~string](value Enummer[T]) reflect.Type {
+//	getEnummerType(&Enum[int]{val: 1}) // returns Enum[int]
+//	getEnummerType(TestInt{&Enum[int]{val: 1}}) // returns TestInt
+//	getEnummerType(&TestInt{&Enum[int]{val: 1}}) // returns TestInt
+func getEnummerType[T Underlying](value Enummer[T]) reflect.Type {
 	t := reflect.TypeOf(value)
-	if t.Kind() == reflect.Ptr {
+	if t != nil && t.Kind() == reflect.Ptr {
 		return t.Elem()
 	}
 	return t
 }
+
+// isNilEnummer returns true if the Enummer is nil or a nil pointer.
+// Methods of a nil pointer to an embedding struct panic.
+func isNilEnummer[T Underlying](e Enummer[T]) bool {
+	if e == nil {
+		return true
+	}
+	v := reflect.ValueOf(e)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
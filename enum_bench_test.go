@@ -6,8 +6,8 @@ import "testing"
 func BenchmarkParse(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
@@ -19,8 +19,8 @@ func BenchmarkParse(b *testing.B) {
 func BenchmarkParsePrealloc(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
 	parse := Parse(enummerList)
 	// Run benchmark
@@ -32,8 +32,8 @@ func BenchmarkParsePrealloc(b *testing.B) {
 // BenchmarkEqual benchmarks the Equal function.
 func BenchmarkEqual(b *testing.B) {
 	// Create enummers
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{1}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 1}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		Equal(e1, e2)
@@ -44,11 +44,11 @@ func BenchmarkEqual(b *testing.B) {
 func BenchmarkGreaterThan(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{2}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 2}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		GreaterThan(enummerList)(e1, e2)
@@ -59,12 +59,12 @@ func BenchmarkGreaterThan(b *testing.B) {
 func BenchmarkGreaterThanPrealloc(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
 	greaterThan := GreaterThan(enummerList)
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{2}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 2}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		greaterThan(e1, e2)
@@ -75,11 +75,11 @@ func BenchmarkGreaterThanPrealloc(b *testing.B) {
 func BenchmarkGreaterThanOrEqual(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{2}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 2}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		GreaterThanOrEqual(enummerList)(e1, e2)
@@ -90,12 +90,12 @@ func BenchmarkGreaterThanOrEqual(b *testing.B) {
 func BenchmarkGreaterThanOrEqualPrealloc(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
 	greaterOrEqualThan := GreaterThanOrEqual(enummerList)
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{2}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 2}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		greaterOrEqualThan(e1, e2)
@@ -107,11 +107,11 @@ func BenchmarkGreaterThanOrEqualPrealloc(b *testing.B) {
 func BenchmarkLessThan(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{2}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 2}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		LessThan(enummerList)(e1, e2)
@@ -122,12 +122,12 @@ func BenchmarkLessThan(b *testing.B) {
 func BenchmarkLessThanPrealloc(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
 	lessThan := LessThan(enummerList)
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{2}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 2}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		lessThan(e1, e2)
@@ -138,11 +138,11 @@ func BenchmarkLessThanPrealloc(b *testing.B) {
 func BenchmarkLessThanOrEqualThan(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{2}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 2}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		LessThanOrEqual(enummerList)(e1, e2)
@@ -153,14 +153,69 @@ func BenchmarkLessThanOrEqualThan(b *testing.B) {
 func BenchmarkLessThanOrEqualThanPrealloc(b *testing.B) {
 	// Create enummers
 	enummerList := []Enummer[int]{
-		&Enum[int]{1},
-		&Enum[int]{2},
+		&Enum[int]{val: 1},
+		&Enum[int]{val: 2},
 	}
 	lessOrEqualThan := LessThanOrEqual(enummerList)
-	e1 := &Enum[int]{1}
-	e2 := &Enum[int]{2}
+	e1 := &Enum[int]{val: 1}
+	e2 := &Enum[int]{val: 2}
 	// Run benchmark
 	for i := 0; i < b.N; i++ {
 		lessOrEqualThan(e1, e2)
 	}
 }
+
+// benchEnummerList returns a list of n int Enummers.
+func benchEnummerList(n int) []Enummer[int] {
+	list := make([]Enummer[int], n)
+	for i := range list {
+		list[i] = &Enum[int]{val: i}
+	}
+	return list
+}
+
+// BenchmarkParseLargePrealloc benchmarks the Parse function with a large list.
+func BenchmarkParseLargePrealloc(b *testing.B) {
+	// Create enummers
+	parse := Parse(benchEnummerList(500))
+	// Run benchmark
+	for i := 0; i < b.N; i++ {
+		parse(499)
+	}
+}
+
+// BenchmarkGreaterThanLargePrealloc benchmarks the GreaterThan function with a large list.
+func BenchmarkGreaterThanLargePrealloc(b *testing.B) {
+	// Create enummers
+	enummerList := benchEnummerList(500)
+	greaterThan := GreaterThan(enummerList)
+	e1 := enummerList[250]
+	e2 := enummerList[499]
+	// Run benchmark
+	for i := 0; i < b.N; i++ {
+		greaterThan(e1, e2)
+	}
+}
+
+// BenchmarkSetParseLarge benchmarks the Set Parse method with a large set.
+func BenchmarkSetParseLarge(b *testing.B) {
+	// Create set
+	set := NewSet(benchEnummerList(500)...)
+	// Run benchmark
+	for i := 0; i < b.N; i++ {
+		set.Parse(499)
+	}
+}
+
+// BenchmarkSetGreaterThanLarge benchmarks the Set GreaterThan method with a large set.
+func BenchmarkSetGreaterThanLarge(b *testing.B) {
+	// Create set
+	enummerList := benchEnummerList(500)
+	set := NewSet(enummerList...)
+	e1 := enummerList[250]
+	e2 := enummerList[499]
+	// Run benchmark
+	for i := 0; i < b.N; i++ {
+		set.GreaterThan(e1, e2)
+	}
+}
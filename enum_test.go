@@ -1,6 +1,7 @@
 package enum
 
 import (
+	"encoding"
 	"encoding/json"
 	"reflect"
 	"testing"
@@ -47,12 +48,12 @@ func TestEnum_New(t *testing.T) {
 		{
 			name:  "int",
 			value: 1,
-			want:  Enum[int]{1},
+			want:  Enum[int]{val: 1},
 		},
 		{
 			name:  "string",
 			value: "hello",
-			want:  Enum[string]{"hello"},
+			want:  Enum[string]{val: "hello"},
 		},
 	}
 	for _, tt := range tests {
@@ -75,22 +76,22 @@ func TestEnum_GetValue(t *testing.T) {
 	}{
 		{
 			name: "int",
-			enum: &Enum[int]{1},
+			enum: &Enum[int]{val: 1},
 			want: 1,
 		},
 		{
 			name: "string",
-			enum: &Enum[string]{"hello"},
+			enum: &Enum[string]{val: "hello"},
 			want: "hello",
 		},
 		{
 			name: "embedded int",
-			enum: &TestTypeInt{Enum[int]{1}},
+			enum: &TestTypeInt{Enum[int]{val: 1}},
 			want: 1,
 		},
 		{
 			name: "embedded string",
-			enum: &TestTypeString{Enum[string]{"hello"}},
+			enum: &TestTypeString{Enum[string]{val: "hello"}},
 			want: "hello",
 		},
 	}
@@ -116,29 +117,29 @@ func TestEnum_MarshalJSON(t *testing.T) {
 	}{
 		{
 			name: "int",
-			enum: &Enum[int]{1},
+			enum: &Enum[int]{val: 1},
 			want: []byte("1"),
 		},
 		{
 			name: "string",
-			enum: &Enum[string]{"hello"},
+			enum: &Enum[string]{val: "hello"},
 			want: []byte("\"hello\""),
 		},
 		{
 			name: "embedded int",
-			enum: &TestTypeInt{Enum[int]{1}},
+			enum: &TestTypeInt{Enum[int]{val: 1}},
 			want: []byte("1"),
 		},
 		{
 			name: "embedded string",
-			enum: &TestTypeString{Enum[string]{"hello"}},
+			enum: &TestTypeString{Enum[string]{val: "hello"}},
 			want: []byte("\"hello\""),
 		},
 		{
 			name: "composite int",
 			enum: TestCompositeInt{
 				TestType: &TestTypeInt{
-					Enum[int]{1},
+					Enum[int]{val: 1},
 				},
 			},
 			want: []byte("{\"test_type\":1}"),
@@ -147,7 +148,7 @@ func TestEnum_MarshalJSON(t *testing.T) {
 			name: "composite string",
 			enum: TestCompositeString{
 				TestType: &TestTypeString{
-					Enum[string]{"hello"},
+					Enum[string]{val: "hello"},
 				},
 			},
 			want: []byte("{\"test_type\":\"hello\"}"),
@@ -171,29 +172,29 @@ func TestEnum_UnmarshalJSON(t *testing.T) {
 		{
 			name: "int",
 			data: []byte("1"),
-			want: &Enum[int]{1},
+			want: &Enum[int]{val: 1},
 		},
 		{
 			name: "string",
 			data: []byte("\"hello\""),
-			want: &Enum[string]{"hello"},
+			want: &Enum[string]{val: "hello"},
 		},
 		{
 			name: "embedded int",
 			data: []byte("1"),
-			want: &TestTypeInt{Enum[int]{1}},
+			want: &TestTypeInt{Enum[int]{val: 1}},
 		},
 		{
 			name: "embedded string",
 			data: []byte("\"hello\""),
-			want: &TestTypeString{Enum[string]{"hello"}},
+			want: &TestTypeString{Enum[string]{val: "hello"}},
 		},
 		{
 			name: "composite int",
 			data: []byte("{\"test_type\":1}"),
 			want: TestCompositeInt{
 				TestType: &TestTypeInt{
-					Enum[int]{1},
+					Enum[int]{val: 1},
 				},
 			},
 		},
@@ -202,7 +203,7 @@ func TestEnum_UnmarshalJSON(t *testing.T) {
 			data: []byte("{\"test_type\":\"hello\"}"),
 			want: TestCompositeString{
 				TestType: &TestTypeString{
-					Enum[string]{"hello"},
+					Enum[string]{val: "hello"},
 				},
 			},
 		},
@@ -247,39 +248,220 @@ func TestEnum_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestEnum_MarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		enum encoding.TextMarshaler
+		want string
+	}{
+		{
+			name: "int",
+			enum: &Enum[int]{val: 1},
+			want: "1",
+		},
+		{
+			name: "string",
+			enum: &Enum[string]{val: "hello"},
+			want: "hello",
+		},
+		{
+			name: "int with name",
+			enum: &TestTypeInt{New(1, WithName("One"))},
+			want: "1",
+		},
+		{
+			name: "string with name",
+			enum: &TestTypeString{New("hello", WithName("Hello"))},
+			want: "hello",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.enum.MarshalText()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestEnum_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    any
+		wantErr error
+	}{
+		{
+			name: "int",
+			text: "1",
+			want: &Enum[int]{val: 1},
+		},
+		{
+			name: "string",
+			text: "hello",
+			want: &Enum[string]{val: "hello"},
+		},
+		{
+			name: "empty string",
+			text: "",
+			want: &Enum[string]{},
+		},
+		{
+			name:    "int name",
+			text:    "One",
+			want:    &Enum[int]{},
+			wantErr: ErrConversion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got encoding.TextUnmarshaler
+			switch tt.want.(type) {
+			case *Enum[int]:
+				got = &Enum[int]{}
+			case *Enum[string]:
+				got = &Enum[string]{}
+			default:
+				require.Fail(t, "unknown type")
+			}
+			err := got.UnmarshalText([]byte(tt.text))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEnum_TextMapKey(t *testing.T) {
+	one := &TestTypeInt{New(1, WithName("One"))}
+
+	data, err := json.Marshal(map[TestTypeInt]int{*one: 1})
+	require.NoError(t, err)
+	require.Equal(t, `{"1":1}`, string(data))
+
+	var got map[TestTypeInt]int
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Len(t, got, 1)
+	for k, v := range got {
+		require.Equal(t, 1, k.GetValue())
+		require.Equal(t, 1, v)
+	}
+
+	// References are encoded with the name of the member
+	data, err = json.Marshal(map[Ref[*TestRefInt]]int{NewRef(TestRefOne): 1})
+	require.NoError(t, err)
+	require.Equal(t, `{"One":1}`, string(data))
+
+	var refs map[Ref[*TestRefInt]]int
+	require.NoError(t, json.Unmarshal(data, &refs))
+	require.Equal(t, map[Ref[*TestRefInt]]int{NewRef(TestRefOne): 1}, refs)
+}
+
 func TestEnum_Scan(t *testing.T) {
 	tests := []struct {
-		name  string
-		value interface{}
-		want  any
+		name    string
+		value   interface{}
+		want    any
+		wantErr error
 	}{
 		{
 			name:  "int",
 			value: 1,
-			want:  &Enum[int]{1},
+			want:  &Enum[int]{val: 1},
 		},
 		{
 			name:  "string",
 			value: "hello",
-			want:  &Enum[string]{"hello"},
+			want:  &Enum[string]{val: "hello"},
+		},
+		{
+			name:  "int from int64",
+			value: int64(1),
+			want:  &Enum[int]{val: 1},
+		},
+		{
+			name:  "int from int32",
+			value: int32(1),
+			want:  &Enum[int]{val: 1},
+		},
+		{
+			name:  "int from bytes",
+			value: []byte("1"),
+			want:  &Enum[int]{val: 1},
+		},
+		{
+			name:  "int from string",
+			value: "1",
+			want:  &Enum[int]{val: 1},
+		},
+		{
+			name:  "int from null",
+			value: nil,
+			want:  &Enum[int]{},
+		},
+		{
+			name:    "int from invalid string",
+			value:   "hello",
+			want:    &Enum[int]{},
+			wantErr: ErrConversion,
+		},
+		{
+			name:    "int from overflowing string",
+			value:   "99999999999999999999",
+			want:    &Enum[int]{},
+			wantErr: ErrConversion,
+		},
+		{
+			name:    "int from float64",
+			value:   1.5,
+			want:    &Enum[int]{},
+			wantErr: ErrConversion,
+		},
+		{
+			name:  "string from bytes",
+			value: []byte("hello"),
+			want:  &Enum[string]{val: "hello"},
+		},
+		{
+			name:  "string from null",
+			value: nil,
+			want:  &Enum[string]{},
+		},
+		{
+			name:    "string from int64",
+			value:   int64(1),
+			want:    &Enum[string]{},
+			wantErr: ErrConversion,
+		},
+		{
+			name:  "embedded string from bytes",
+			value: []byte("hello"),
+			want:  &TestTypeString{Enum[string]{val: "hello"}},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			var got interface{ Scan(interface{}) error }
 			switch tt.want.(type) {
 			case *Enum[int]:
-				got := &Enum[int]{}
-				err := got.Scan(tt.value)
-				require.NoError(t, err)
-				require.Equal(t, tt.want, got)
+				got = &Enum[int]{}
 			case *Enum[string]:
-				got := &Enum[string]{}
-				err := got.Scan(tt.value)
-				require.NoError(t, err)
-				require.Equal(t, tt.want, got)
+				got = &Enum[string]{}
+			case *TestTypeString:
+				got = &TestTypeString{}
 			default:
 				require.Fail(t, "unknown type")
 			}
+			err := got.Scan(tt.value)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -292,12 +474,12 @@ func TestEnum_Value(t *testing.T) {
 	}{
 		{
 			name:  "int",
-			value: &Enum[int]{1},
-			want:  1,
+			value: &Enum[int]{val: 1},
+			want:  int64(1),
 		},
 		{
 			name:  "string",
-			value: &Enum[string]{"hello"},
+			value: &Enum[string]{val: "hello"},
 			want:  "hello",
 		},
 	}
@@ -329,25 +511,25 @@ func TestParse(t *testing.T) {
 	}{
 		{
 			name:  "int",
-			list:  []Enummer[int]{&Enum[int]{1}},
+			list:  []Enummer[int]{&Enum[int]{val: 1}},
 			value: 1,
-			want:  &Enum[int]{1},
+			want:  &Enum[int]{val: 1},
 		},
 		{
 			name:  "string",
-			list:  []Enummer[string]{&Enum[string]{"hello"}},
+			list:  []Enummer[string]{&Enum[string]{val: "hello"}},
 			value: "hello",
-			want:  &Enum[string]{"hello"},
+			want:  &Enum[string]{val: "hello"},
 		},
 		{
 			name:  "int not found",
-			list:  []Enummer[int]{&Enum[int]{1}},
+			list:  []Enummer[int]{&Enum[int]{val: 1}},
 			value: 2,
 			want:  nil,
 		},
 		{
 			name:  "string not found",
-			list:  []Enummer[string]{&Enum[string]{"hello"}},
+			list:  []Enummer[string]{&Enum[string]{val: "hello"}},
 			value: "world",
 			want:  nil,
 		},
@@ -376,25 +558,25 @@ func TestMustParse(t *testing.T) {
 	}{
 		{
 			name:  "int",
-			list:  []Enummer[int]{&Enum[int]{1}},
+			list:  []Enummer[int]{&Enum[int]{val: 1}},
 			value: 1,
-			want:  &Enum[int]{1},
+			want:  &Enum[int]{val: 1},
 		},
 		{
 			name:  "string",
-			list:  []Enummer[string]{&Enum[string]{"hello"}},
+			list:  []Enummer[string]{&Enum[string]{val: "hello"}},
 			value: "hello",
-			want:  &Enum[string]{"hello"},
+			want:  &Enum[string]{val: "hello"},
 		},
 		{
 			name:       "int not found",
-			list:       []Enummer[int]{&Enum[int]{1}},
+			list:       []Enummer[int]{&Enum[int]{val: 1}},
 			value:      2,
 			wantPanics: true,
 		},
 		{
 			name:       "string not found",
-			list:       []Enummer[string]{&Enum[string]{"hello"}},
+			list:       []Enummer[string]{&Enum[string]{val: "hello"}},
 			value:      "world",
 			wantPanics: true,
 		},
@@ -421,6 +603,52 @@ func TestMustParse(t *testing.T) {
 	}
 }
 
+func TestTryParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    []Enummer[int]
+		value   int
+		want    Enummer[int]
+		wantErr error
+	}{
+		{
+			name:  "found",
+			list:  []Enummer[int]{&Enum[int]{val: 1}},
+			value: 1,
+			want:  &Enum[int]{val: 1},
+		},
+		{
+			name:    "not found",
+			list:    []Enummer[int]{&Enum[int]{val: 1}},
+			value:   2,
+			wantErr: ErrUnknownValue,
+		},
+		{
+			name:    "empty list",
+			list:    []Enummer[int]{},
+			value:   1,
+			wantErr: ErrEmptyList,
+		},
+		{
+			name:    "two types",
+			list:    []Enummer[int]{&TestTypeInt{Enum[int]{val: 1}}, &Test2TypeInt{Enum[int]{val: 2}}},
+			value:   1,
+			wantErr: ErrTypeMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryParse(tt.list)(tt.value)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestEqual(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -431,30 +659,30 @@ func TestEqual(t *testing.T) {
 	}{
 		{
 			name:       "int equal",
-			enumFirst:  &Enum[int]{1},
-			enumSecond: &Enum[int]{1},
+			enumFirst:  &Enum[int]{val: 1},
+			enumSecond: &Enum[int]{val: 1},
 			want:       true,
 		},
 		{
 			name:       "int not equal",
-			enumFirst:  &Enum[int]{1},
-			enumSecond: &Enum[int]{2},
+			enumFirst:  &Enum[int]{val: 1},
+			enumSecond: &Enum[int]{val: 2},
 		},
 		{
 			name:       "string equal",
-			enumFirst:  &Enum[string]{"hello"},
-			enumSecond: &Enum[string]{"hello"},
+			enumFirst:  &Enum[string]{val: "hello"},
+			enumSecond: &Enum[string]{val: "hello"},
 			want:       true,
 		},
 		{
 			name:       "string not equal",
-			enumFirst:  &Enum[string]{"hello"},
-			enumSecond: &Enum[string]{"world"},
+			enumFirst:  &Enum[string]{val: "hello"},
+			enumSecond: &Enum[string]{val: "world"},
 		},
 		{
 			name:       "int two types panic",
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 	}
@@ -480,6 +708,88 @@ func TestEqual(t *testing.T) {
 	}
 }
 
+func TestTryEqual(t *testing.T) {
+	equal, err := TryEqual[int](&TestTypeInt{Enum[int]{val: 1}}, &TestTypeInt{Enum[int]{val: 1}})
+	require.NoError(t, err)
+	require.True(t, equal)
+
+	_, err = TryEqual[int](&TestTypeInt{Enum[int]{val: 1}}, &Test2TypeInt{Enum[int]{val: 1}})
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	var mismatchErr *TypeMismatchError
+	require.ErrorAs(t, err, &mismatchErr)
+	require.Equal(t, reflect.TypeOf(TestTypeInt{}), mismatchErr.A)
+	require.Equal(t, reflect.TypeOf(Test2TypeInt{}), mismatchErr.B)
+
+	// Nil enums are only equal to nil enums
+	var nilInt *TestTypeInt
+	equal, err = TryEqual[int](nilInt, nilInt)
+	require.NoError(t, err)
+	require.True(t, equal)
+	equal, err = TryEqual[int](nilInt, &TestTypeInt{Enum[int]{val: 0}})
+	require.NoError(t, err)
+	require.False(t, equal)
+	_, err = TryEqual[int](nil, &TestTypeInt{Enum[int]{val: 0}})
+	require.ErrorIs(t, err, ErrTypeMismatch)
+}
+
+func TestTryCompare(t *testing.T) {
+	list := []Enummer[int]{&TestTypeInt{Enum[int]{val: 1}}, &TestTypeInt{Enum[int]{val: 2}}}
+	tests := []struct {
+		name    string
+		compare func([]Enummer[int]) func(Enummer[int], Enummer[int]) (bool, error)
+		lower   bool
+		equal   bool
+		greater bool
+	}{
+		{
+			name:    "greater than",
+			compare: TryGreaterThan[int],
+			greater: true,
+		},
+		{
+			name:    "greater than or equal",
+			compare: TryGreaterThanOrEqual[int],
+			equal:   true,
+			greater: true,
+		},
+		{
+			name:    "less than",
+			compare: TryLessThan[int],
+			lower:   true,
+		},
+		{
+			name:    "less than or equal",
+			compare: TryLessThanOrEqual[int],
+			lower:   true,
+			equal:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compare := tt.compare(list)
+			for _, c := range []struct {
+				a, b Enummer[int]
+				want bool
+			}{
+				{list[0], list[1], tt.lower},
+				{list[0], list[0], tt.equal},
+				{list[1], list[0], tt.greater},
+			} {
+				got, err := compare(c.a, c.b)
+				require.NoError(t, err)
+				require.Equal(t, c.want, got)
+			}
+
+			_, err := compare(list[0], &TestTypeInt{Enum[int]{val: 3}})
+			require.ErrorIs(t, err, ErrNotInList)
+			_, err = compare(list[0], &Test2TypeInt{Enum[int]{val: 1}})
+			require.ErrorIs(t, err, ErrTypeMismatch)
+			_, err = tt.compare(nil)(list[0], list[1])
+			require.ErrorIs(t, err, ErrEmptyList)
+		})
+	}
+}
+
 // TestGreaterThan tests the GreaterThan method
 func TestGreaterThan(t *testing.T) {
 	tests := []struct {
@@ -493,59 +803,59 @@ func TestGreaterThan(t *testing.T) {
 		{
 			name: "int lower",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{1},
-			enumSecond: &Enum[int]{2},
+			enumFirst:  &Enum[int]{val: 1},
+			enumSecond: &Enum[int]{val: 2},
 		},
 		{
 			name: "int greater",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{2},
-			enumSecond: &Enum[int]{1},
+			enumFirst:  &Enum[int]{val: 2},
+			enumSecond: &Enum[int]{val: 1},
 			want:       true,
 		},
 		{
 			name: "string lower",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"hello"},
-			enumSecond: &Enum[string]{"world"},
+			enumFirst:  &Enum[string]{val: "hello"},
+			enumSecond: &Enum[string]{val: "world"},
 		},
 		{
 			name: "string greater",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"world"},
-			enumSecond: &Enum[string]{"hello"},
+			enumFirst:  &Enum[string]{val: "world"},
+			enumSecond: &Enum[string]{val: "hello"},
 			want:       true,
 		},
 		{
 			name: "int two types panic list",
 			enums: []Enummer[int]{
-				&TestTypeInt{Enum[int]{1}},
-				&Test2TypeInt{Enum[int]{1}},
+				&TestTypeInt{Enum[int]{val: 1}},
+				&Test2TypeInt{Enum[int]{val: 1}},
 			},
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 		{
 			name: "int two types panic equal",
 			enums: []Enummer[int]{
-				&TestTypeInt{Enum[int]{1}},
-				&TestTypeInt{Enum[int]{2}},
+				&TestTypeInt{Enum[int]{val: 1}},
+				&TestTypeInt{Enum[int]{val: 2}},
 			},
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 	}
@@ -595,79 +905,79 @@ func TestGreaterThanOrEqual(t *testing.T) {
 		{
 			name: "int equal",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{1},
-			enumSecond: &Enum[int]{1},
+			enumFirst:  &Enum[int]{val: 1},
+			enumSecond: &Enum[int]{val: 1},
 			want:       true,
 		},
 		{
 			name: "int lower",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{1},
-			enumSecond: &Enum[int]{2},
+			enumFirst:  &Enum[int]{val: 1},
+			enumSecond: &Enum[int]{val: 2},
 		},
 		{
 			name: "int greater",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{2},
-			enumSecond: &Enum[int]{1},
+			enumFirst:  &Enum[int]{val: 2},
+			enumSecond: &Enum[int]{val: 1},
 			want:       true,
 		},
 		{
 			name: "string equal",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"hello"},
-			enumSecond: &Enum[string]{"hello"},
+			enumFirst:  &Enum[string]{val: "hello"},
+			enumSecond: &Enum[string]{val: "hello"},
 			want:       true,
 		},
 		{
 			name: "string lower",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"hello"},
-			enumSecond: &Enum[string]{"world"},
+			enumFirst:  &Enum[string]{val: "hello"},
+			enumSecond: &Enum[string]{val: "world"},
 		},
 		{
 			name: "string greater",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"world"},
-			enumSecond: &Enum[string]{"hello"},
+			enumFirst:  &Enum[string]{val: "world"},
+			enumSecond: &Enum[string]{val: "hello"},
 			want:       true,
 		},
 		{
 			name: "int two types panic list",
 			enums: []Enummer[int]{
-				&TestTypeInt{Enum[int]{1}},
-				&Test2TypeInt{Enum[int]{1}},
+				&TestTypeInt{Enum[int]{val: 1}},
+				&Test2TypeInt{Enum[int]{val: 1}},
 			},
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 		{
 			name: "int two types panic equal",
 			enums: []Enummer[int]{
-				&TestTypeInt{Enum[int]{1}},
-				&TestTypeInt{Enum[int]{2}},
+				&TestTypeInt{Enum[int]{val: 1}},
+				&TestTypeInt{Enum[int]{val: 2}},
 			},
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 	}
@@ -717,59 +1027,59 @@ func TestLessThan(t *testing.T) {
 		{
 			name: "int lower",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{1},
-			enumSecond: &Enum[int]{2},
+			enumFirst:  &Enum[int]{val: 1},
+			enumSecond: &Enum[int]{val: 2},
 			want:       true,
 		},
 		{
 			name: "int greater",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{2},
-			enumSecond: &Enum[int]{1},
+			enumFirst:  &Enum[int]{val: 2},
+			enumSecond: &Enum[int]{val: 1},
 		},
 		{
 			name: "string lower",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"hello"},
-			enumSecond: &Enum[string]{"world"},
+			enumFirst:  &Enum[string]{val: "hello"},
+			enumSecond: &Enum[string]{val: "world"},
 			want:       true,
 		},
 		{
 			name: "string greater",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"world"},
-			enumSecond: &Enum[string]{"hello"},
+			enumFirst:  &Enum[string]{val: "world"},
+			enumSecond: &Enum[string]{val: "hello"},
 		},
 		{
 			name: "int two types panic list",
 			enums: []Enummer[int]{
-				&TestTypeInt{Enum[int]{1}},
-				&Test2TypeInt{Enum[int]{1}},
+				&TestTypeInt{Enum[int]{val: 1}},
+				&Test2TypeInt{Enum[int]{val: 1}},
 			},
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 		{
 			name: "int two types panic equal",
 			enums: []Enummer[int]{
-				&TestTypeInt{Enum[int]{1}},
-				&TestTypeInt{Enum[int]{2}},
+				&TestTypeInt{Enum[int]{val: 1}},
+				&TestTypeInt{Enum[int]{val: 2}},
 			},
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 	}
@@ -819,79 +1129,79 @@ func TestLessThanOrEqual(t *testing.T) {
 		{
 			name: "int equal",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{1},
-			enumSecond: &Enum[int]{1},
+			enumFirst:  &Enum[int]{val: 1},
+			enumSecond: &Enum[int]{val: 1},
 			want:       true,
 		},
 		{
 			name: "int lower",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{1},
-			enumSecond: &Enum[int]{2},
+			enumFirst:  &Enum[int]{val: 1},
+			enumSecond: &Enum[int]{val: 2},
 			want:       true,
 		},
 		{
 			name: "int greater",
 			enums: []Enummer[int]{
-				&Enum[int]{1},
-				&Enum[int]{2},
+				&Enum[int]{val: 1},
+				&Enum[int]{val: 2},
 			},
-			enumFirst:  &Enum[int]{2},
-			enumSecond: &Enum[int]{1},
+			enumFirst:  &Enum[int]{val: 2},
+			enumSecond: &Enum[int]{val: 1},
 		},
 		{
 			name: "string equal",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"hello"},
-			enumSecond: &Enum[string]{"hello"},
+			enumFirst:  &Enum[string]{val: "hello"},
+			enumSecond: &Enum[string]{val: "hello"},
 			want:       true,
 		},
 		{
 			name: "string lower",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"hello"},
-			enumSecond: &Enum[string]{"world"},
+			enumFirst:  &Enum[string]{val: "hello"},
+			enumSecond: &Enum[string]{val: "world"},
 			want:       true,
 		},
 		{
 			name: "string greater",
 			enums: []Enummer[string]{
-				&Enum[string]{"hello"},
-				&Enum[string]{"world"},
+				&Enum[string]{val: "hello"},
+				&Enum[string]{val: "world"},
 			},
-			enumFirst:  &Enum[string]{"world"},
-			enumSecond: &Enum[string]{"hello"},
+			enumFirst:  &Enum[string]{val: "world"},
+			enumSecond: &Enum[string]{val: "hello"},
 		},
 		{
 			name: "int two types panic list",
 			enums: []Enummer[int]{
-				&TestTypeInt{Enum[int]{1}},
-				&Test2TypeInt{Enum[int]{1}},
+				&TestTypeInt{Enum[int]{val: 1}},
+				&Test2TypeInt{Enum[int]{val: 1}},
 			},
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 		{
 			name: "int two types panic equal",
 			enums: []Enummer[int]{
-				&TestTypeInt{Enum[int]{1}},
-				&TestTypeInt{Enum[int]{2}},
+				&TestTypeInt{Enum[int]{val: 1}},
+				&TestTypeInt{Enum[int]{val: 2}},
 			},
-			enumFirst:  &TestTypeInt{Enum[int]{1}},
-			enumSecond: &Test2TypeInt{Enum[int]{1}},
+			enumFirst:  &TestTypeInt{Enum[int]{val: 1}},
+			enumSecond: &Test2TypeInt{Enum[int]{val: 1}},
 			wantPanics: true,
 		},
 	}
@@ -938,38 +1248,38 @@ func Test_compareEnummerType(t *testing.T) {
 	}{
 		{
 			name:  "int",
-			enum1: &Enum[int]{1},
-			enum2: &Enum[int]{1},
+			enum1: &Enum[int]{val: 1},
+			enum2: &Enum[int]{val: 1},
 			want:  true,
 		},
 		{
 			name:  "string",
-			enum1: &Enum[string]{"hello"},
-			enum2: &Enum[string]{"hello"},
+			enum1: &Enum[string]{val: "hello"},
+			enum2: &Enum[string]{val: "hello"},
 			want:  true,
 		},
 		{
 			name:  "int two types",
-			enum1: &TestTypeInt{Enum[int]{1}},
-			enum2: &Test2TypeInt{Enum[int]{1}},
+			enum1: &TestTypeInt{Enum[int]{val: 1}},
+			enum2: &Test2TypeInt{Enum[int]{val: 1}},
 			want:  false,
 		},
 		{
 			name:  "int pointer two types",
-			enum1: &TestTypeInt{Enum[int]{1}},
-			enum2: &Test2TypeInt{Enum[int]{1}},
+			enum1: &TestTypeInt{Enum[int]{val: 1}},
+			enum2: &Test2TypeInt{Enum[int]{val: 1}},
 			want:  false,
 		},
 		{
 			name:  "string two types",
-			enum1: &TestTypeString{Enum[string]{"hello"}},
-			enum2: &Test2TypeString{Enum[string]{"hello"}},
+			enum1: &TestTypeString{Enum[string]{val: "hello"}},
+			enum2: &Test2TypeString{Enum[string]{val: "hello"}},
 			want:  false,
 		},
 		{
 			name:  "string pointer two types",
-			enum1: &TestTypeString{Enum[string]{"hello"}},
-			enum2: &Test2TypeString{Enum[string]{"hello"}},
+			enum1: &TestTypeString{Enum[string]{val: "hello"}},
+			enum2: &Test2TypeString{Enum[string]{val: "hello"}},
 			want:  false,
 		},
 	}
@@ -999,32 +1309,32 @@ func Test_getEnummerType(t *testing.T) {
 	}{
 		{
 			name: "int",
-			enum: &Enum[int]{1},
+			enum: &Enum[int]{val: 1},
 			want: reflect.TypeOf(Enum[int]{}),
 		},
 		{
 			name: "string",
-			enum: &Enum[string]{"hello"},
+			enum: &Enum[string]{val: "hello"},
 			want: reflect.TypeOf(Enum[string]{}),
 		},
 		{
 			name: "embedded int",
-			enum: &TestTypeInt{Enum[int]{1}},
+			enum: &TestTypeInt{Enum[int]{val: 1}},
 			want: reflect.TypeOf(TestTypeInt{}),
 		},
 		{
 			name: "embedded string",
-			enum: &TestTypeString{Enum[string]{"hello"}},
+			enum: &TestTypeString{Enum[string]{val: "hello"}},
 			want: reflect.TypeOf(TestTypeString{}),
 		},
 		{
 			name: "embedded int pointer",
-			enum: &TestTypeInt{Enum[int]{1}},
+			enum: &TestTypeInt{Enum[int]{val: 1}},
 			want: reflect.TypeOf(TestTypeInt{}),
 		},
 		{
 			name: "embedded string pointer",
-			enum: &TestTypeString{Enum[string]{"hello"}},
+			enum: &TestTypeString{Enum[string]{val: "hello"}},
 			want: reflect.TypeOf(TestTypeString{}),
 		},
 	}
@@ -1041,3 +1351,21 @@ func Test_getEnummerType(t *testing.T) {
 		})
 	}
 }
+
+func Test_isNilEnummer(t *testing.T) {
+	var nilInt *TestTypeInt
+	require.True(t, isNilEnummer[int](nil))
+	require.True(t, isNilEnummer[int](nilInt))
+	require.False(t, isNilEnummer[int](&TestTypeInt{Enum[int]{val: 1}}))
+	require.False(t, isNilEnummer[int](&Enum[int]{}))
+}
+
+func TestEqual_Allocs(t *testing.T) {
+	a, b := &TestTypeInt{Enum[int]{val: 1}}, &TestTypeInt{Enum[int]{val: 2}}
+	set := NewSet[int](a, b)
+	allocs := testing.AllocsPerRun(100, func() {
+		Equal[int](a, b)
+		set.LessThan(a, b)
+	})
+	require.Zero(t, allocs)
+}
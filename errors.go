@@ -0,0 +1,276 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrUnknownValue is returned when a value does not match any member of an enum.
+	ErrUnknownValue = errors.New("enum: unknown value")
+	// ErrTypeMismatch is returned when enums of different types are used together.
+	ErrTypeMismatch = errors.New("enum: type mismatch")
+	// ErrEmptyList is returned when an enum list has no member.
+	ErrEmptyList = errors.New("enum: list is empty")
+	// ErrNotInList is returned when an enum is not a member of a list.
+	ErrNotInList = errors.New("enum: not found in list")
+	// ErrDuplicateValue is returned when a value is declared twice in a list.
+	ErrDuplicateValue = errors.New("enum: duplicate value")
+	// ErrConversion is returned when a value cannot be converted into an enum value.
+	ErrConversion = errors.New("enum: cannot convert")
+	// ErrOverflow is returned when a value overflows the enum underlying type.
+	ErrOverflow = errors.New("enum: value overflows")
+	// ErrNotBound is returned when an enum type is not bound to a set.
+	ErrNotBound = errors.New("enum: type not bound to a set")
+	// ErrAlreadyBound is returned when an enum type is already bound to another set.
+	ErrAlreadyBound = errors.New("enum: type already bound to another set")
+	// ErrSetBound is returned when a bound set is configured: sets must be bound last.
+	ErrSetBound = errors.New("enum: set already bound")
+	// ErrIllegalTransition is returned when a transition between two members is not allowed.
+	ErrIllegalTransition = errors.New("enum: illegal transition")
+	// ErrUnreachableState is returned when a member cannot be reached from the initial member.
+	ErrUnreachableState = errors.New("enum: unreachable state")
+	// ErrDeadState is returned when a member has no declared transitions.
+	ErrDeadState = errors.New("enum: dead state")
+	// ErrDuplicateRank is returned when two members have the same rank in a strictly ordered set.
+	ErrDuplicateRank = errors.New("enum: duplicate rank")
+	// ErrFrozen is returned when a value is decoded into a frozen member.
+	ErrFrozen = errors.New("enum: frozen member")
+	// ErrInvalidBit is returned when a member has no valid bit in Flags.
+	ErrInvalidBit = errors.New("enum: invalid flag bit")
+	// ErrDuplicateBit is returned when two members have the same flag bit in a set.
+	ErrDuplicateBit = errors.New("enum: duplicate flag bit")
+	// ErrUnknownCodec is returned when no codec is registered for a format.
+	ErrUnknownCodec = errors.New("enum: unknown codec")
+	// ErrCodecRegistered is returned when a codec is already registered for a format.
+	ErrCodecRegistered = errors.New("enum: codec already registered")
+)
+
+// UnknownValueError is returned when a value does not match any member of an enum.
+// It matches ErrUnknownValue with errors.Is.
+type UnknownValueError struct {
+	// The offending value
+	Value any
+	// The type of the enum members
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *UnknownValueError) Error() string {
+	return fmt.Sprintf("enum: unknown value '%v' for '%v'", e.Value, e.Type)
+}
+
+// Is returns true if the target is ErrUnknownValue.
+func (e *UnknownValueError) Is(target error) bool {
+	return target == ErrUnknownValue
+}
+
+// TypeMismatchError is returned when enums of different types are used together.
+// It matches ErrTypeMismatch with errors.Is.
+type TypeMismatchError struct {
+	// The type of the first enum
+	A reflect.Type
+	// The type of the second enum
+	B reflect.Type
+}
+
+// Error implements the error interface.
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("enum: different types '%v' and '%v'", e.A, e.B)
+}
+
+// Is returns true if the target is ErrTypeMismatch.
+func (e *TypeMismatchError) Is(target error) bool {
+	return target == ErrTypeMismatch
+}
+
+// NotInListError is returned when an enum is not a member of a list.
+// It matches ErrNotInList with errors.Is.
+type NotInListError struct {
+	// The offending value
+	Value any
+	// The type of the enum
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *NotInListError) Error() string {
+	return fmt.Sprintf("enum: '%v' of type '%v' not found in list", e.Value, e.Type)
+}
+
+// Is returns true if the target is ErrNotInList.
+func (e *NotInListError) Is(target error) bool {
+	return target == ErrNotInList
+}
+
+// DuplicateValueError is returned when a value is declared twice in a list.
+// It matches ErrDuplicateValue with errors.Is.
+type DuplicateValueError struct {
+	// The duplicated value
+	Value any
+	// The type of the enum members
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *DuplicateValueError) Error() string {
+	return fmt.Sprintf("enum: duplicate value '%v' for '%v'", e.Value, e.Type)
+}
+
+// Is returns true if the target is ErrDuplicateValue.
+func (e *DuplicateValueError) Is(target error) bool {
+	return target == ErrDuplicateValue
+}
+
+// IllegalTransitionError is returned when a transition between two members is not allowed.
+// It matches ErrIllegalTransition with errors.Is.
+type IllegalTransitionError struct {
+	// The source member
+	From any
+	// The target member
+	To any
+	// The type of the enum
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *IllegalTransitionError) Error() string {
+	return fmt.Sprintf("enum: illegal transition from '%v' to '%v' for '%v'", e.From, e.To, e.Type)
+}
+
+// Is returns true if the target is ErrIllegalTransition.
+func (e *IllegalTransitionError) Is(target error) bool {
+	return target == ErrIllegalTransition
+}
+
+// UnreachableStateError is returned when a member cannot be reached from the initial member.
+// It matches ErrUnreachableState with errors.Is.
+type UnreachableStateError struct {
+	// The unreachable member
+	Value any
+	// The type of the enum
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *UnreachableStateError) Error() string {
+	return fmt.Sprintf("enum: state '%v' of type '%v' is unreachable", e.Value, e.Type)
+}
+
+// Is returns true if the target is ErrUnreachableState.
+func (e *UnreachableStateError) Is(target error) bool {
+	return target == ErrUnreachableState
+}
+
+// DeadStateError is returned when a member has no declared transitions.
+// It matches ErrDeadState with errors.Is.
+type DeadStateError struct {
+	// The dead member
+	Value any
+	// The type of the enum
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *DeadStateError) Error() string {
+	return fmt.Sprintf("enum: state '%v' of type '%v' has no declared transitions", e.Value, e.Type)
+}
+
+// Is returns true if the target is ErrDeadState.
+func (e *DeadStateError) Is(target error) bool {
+	return target == ErrDeadState
+}
+
+// DuplicateRankError is returned when two members have the same rank in a strictly ordered set.
+// It matches ErrDuplicateRank with errors.Is.
+type DuplicateRankError struct {
+	// The duplicated rank
+	Rank int
+	// The members with the same rank
+	A, B any
+	// The type of the enum
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *DuplicateRankError) Error() string {
+	return fmt.Sprintf("enum: '%v' and '%v' of type '%v' have the same rank %d", e.A, e.B, e.Type, e.Rank)
+}
+
+// Is returns true if the target is ErrDuplicateRank.
+func (e *DuplicateRankError) Is(target error) bool {
+	return target == ErrDuplicateRank
+}
+
+// DuplicateBitError is returned when two members have the same flag bit in a set.
+// It matches ErrDuplicateBit with errors.Is.
+type DuplicateBitError struct {
+	// The duplicated bit
+	Bit int
+	// The members with the same bit
+	A, B any
+	// The type of the enum
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *DuplicateBitError) Error() string {
+	return fmt.Sprintf("enum: '%v' and '%v' of type '%v' have the same flag bit %d", e.A, e.B, e.Type, e.Bit)
+}
+
+// Is returns true if the target is ErrDuplicateBit.
+func (e *DuplicateBitError) Is(target error) bool {
+	return target == ErrDuplicateBit
+}
+
+// ConversionError is returned when a value cannot be converted into an enum value.
+// It matches ErrConversion with errors.Is and unwraps to the underlying error,
+// such as ErrOverflow or a strconv error.
+type ConversionError struct {
+	// The offending value
+	Value any
+	// The enum underlying type
+	Type reflect.Type
+	// The underlying error, if any
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ConversionError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("enum: cannot convert '%v' (%T) to '%v': %v", e.Value, e.Value, e.Type, e.Err)
+	}
+	return fmt.Sprintf("enum: cannot convert '%v' (%T) to '%v'", e.Value, e.Value, e.Type)
+}
+
+// Is returns true if the target is ErrConversion.
+func (e *ConversionError) Is(target error) bool {
+	return target == ErrConversion
+}
+
+// Unwrap returns the underlying error.
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// FrozenError is returned when a value is decoded into a frozen member.
+// It matches ErrFrozen with errors.Is.
+type FrozenError struct {
+	// The value of the frozen member
+	Value any
+	// The decoded value
+	Decoded any
+	// The type of the enum
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *FrozenError) Error() string {
+	return fmt.Sprintf("enum: cannot decode '%v' into frozen member '%v' of '%v'", e.Decoded, e.Value, e.Type)
+}
+
+// Is returns true if the target is ErrFrozen.
+func (e *FrozenError) Is(target error) bool {
+	return target == ErrFrozen
+}
@@ -14,18 +14,18 @@ var (
 	TestStateCompareSkipped = &TestStateCompare{enum.New("skipped")}
 	TestStateCompareFailed  = &TestStateCompare{enum.New("failed")}
 
-	// Define the ordered list of states
-	// Higher states in the list are considered greater than lower states
-	TestStateCompares = []enum.Enummer[string]{
+	// Define the ordered set of states
+	// Higher states in the set are considered greater than lower states
+	TestStateCompares = enum.NewSet[string](
 		TestStateCompareUnknown,
 		TestStateComparePassed,
 		TestStateCompareSkipped,
 		TestStateCompareFailed,
-	}
+	)
 
 	// Define states parsers
-	ParseTestStateCompare     = enum.Parse(TestStateCompares)
-	MustParseTestStateCompare = enum.MustParse(TestStateCompares)
+	ParseTestStateCompare     = TestStateCompares.Parse
+	MustParseTestStateCompare = TestStateCompares.MustParse
 )
 
 // Define the state enum
@@ -38,19 +38,19 @@ func (ts *TestStateCompare) Equal(other *TestStateCompare) bool {
 }
 
 func (ts *TestStateCompare) GreaterThan(other *TestStateCompare) bool {
-	return enum.GreaterThan(TestStateCompares)(ts, other)
+	return TestStateCompares.GreaterThan(ts, other)
 }
 
 func (ts *TestStateCompare) LessThan(other *TestStateCompare) bool {
-	return enum.LessThan(TestStateCompares)(ts, other)
+	return TestStateCompares.LessThan(ts, other)
 }
 
 func (ts *TestStateCompare) GreaterThanOrEqual(other *TestStateCompare) bool {
-	return enum.GreaterThanOrEqual(TestStateCompares)(ts, other)
+	return TestStateCompares.GreaterThanOrEqual(ts, other)
 }
 
 func (ts *TestStateCompare) LessThanOrEqual(other *TestStateCompare) bool {
-	return enum.LessThanOrEqual(TestStateCompares)(ts, other)
+	return TestStateCompares.LessThanOrEqual(ts, other)
 }
 
 func Example_compare() {
@@ -113,5 +113,5 @@ func Example_compare() {
 	// passed
 	// <nil>
 	// passed
-	// recovered from panic: enum: 'xxx' not found
+	// recovered from panic: enum: unknown value 'xxx' for '*enum_test.TestStateCompare'
 }
@@ -0,0 +1,57 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	enum "github.com/FabienMht/go-struct-enum"
+)
+
+var (
+	// Define priorities with their metadata
+	PriorityLow = &Priority{enum.New(1,
+		enum.WithName("Low"),
+		enum.WithDescription("Handled when possible"),
+	)}
+	PriorityHigh = &Priority{enum.New(2,
+		enum.WithName("High"),
+		enum.WithDescription("Handled first"),
+		enum.WithAttribute("color", "red"),
+	)}
+
+	// Define the ordered set of priorities
+	Priorities = enum.NewSet[int](
+		PriorityLow,
+		PriorityHigh,
+	)
+)
+
+// Define the priority enum
+type Priority struct {
+	enum.Enum[int]
+}
+
+func Example_metadata() {
+	// The name is used as string representation
+	fmt.Println(PriorityHigh)
+	fmt.Println(PriorityHigh.GetValue())
+	fmt.Println(PriorityHigh.Description())
+	fmt.Println(PriorityHigh.Attribute("color"))
+
+	// JSON marshaling uses the value
+	fmt.Println(json.Marshal(PriorityHigh))
+
+	// List the priorities
+	for _, p := range Priorities.Members() {
+		fmt.Printf("%d: %s\n", p.GetValue(), p)
+	}
+
+	// Output:
+	// High
+	// 2
+	// Handled first
+	// red true
+	// [50] <nil>
+	// 1: Low
+	// 2: High
+}
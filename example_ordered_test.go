@@ -0,0 +1,50 @@
+package enum_test
+
+import (
+	"fmt"
+
+	enum "github.com/FabienMht/go-struct-enum"
+)
+
+var (
+	// Define States
+	TestStateOrderedUnknown = &TestStateOrdered{enum.NewOrdered("")}
+	TestStateOrderedPassed  = &TestStateOrdered{enum.NewOrdered("passed")}
+	TestStateOrderedSkipped = &TestStateOrdered{enum.NewOrdered("skipped")}
+	TestStateOrderedFailed  = &TestStateOrdered{enum.NewOrdered("failed")}
+
+	// Define the ordered set of states and link the members to it
+	// Higher states in the set are considered greater than lower states
+	TestStateOrdereds = enum.NewSet[string](
+		TestStateOrderedUnknown,
+		TestStateOrderedPassed,
+		TestStateOrderedSkipped,
+		TestStateOrderedFailed,
+	).Bind()
+)
+
+// Define the state enum
+// The comparison methods are provided by the embedded ordered enum
+type TestStateOrdered struct {
+	enum.Ordered[string]
+}
+
+func Example_ordered() {
+	// Comparison
+	fmt.Println(TestStateOrderedPassed.Equal(TestStateOrderedPassed))
+	fmt.Println(TestStateOrderedPassed.Equal(TestStateOrderedFailed))
+	fmt.Println(TestStateOrderedPassed.GreaterThan(TestStateOrderedFailed))
+	fmt.Println(TestStateOrderedPassed.GreaterThanOrEqual(TestStateOrderedPassed))
+	fmt.Println(TestStateOrderedPassed.LessThan(TestStateOrderedFailed))
+	fmt.Println(TestStateOrderedPassed.LessThanOrEqual(TestStateOrderedSkipped))
+	fmt.Println(TestStateOrderedPassed.Compare(TestStateOrderedUnknown))
+
+	// Output:
+	// true
+	// false
+	// false
+	// true
+	// true
+	// true
+	// 1
+}
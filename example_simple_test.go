@@ -14,17 +14,18 @@ var (
 	TestStateSkipped = &TestState{enum.New("skipped")}
 	TestStateFailed  = &TestState{enum.New("failed")}
 
-	// Define the ordered list of states
-	TestStates = []enum.Enummer[string]{
+	// Define the ordered set of states
+	// Bind the set to resolve references to the declared states
+	TestStates = enum.NewSet[string](
 		TestStateUnknown,
 		TestStatePassed,
 		TestStateSkipped,
 		TestStateFailed,
-	}
+	).Bind()
 
 	// Define states parsers
-	ParseTestState     = enum.Parse(TestStates)
-	MustParseTestState = enum.MustParse(TestStates)
+	ParseTestState     = TestStates.Parse
+	MustParseTestState = TestStates.MustParse
 )
 
 // Define the state enum
@@ -53,6 +54,15 @@ func Example() {
 	json.Unmarshal([]byte("\"passed\""), &result)
 	fmt.Println(result)
 
+	// JSON unmarshalling into the declared states
+	var ref enum.Ref[*TestState]
+	fmt.Println(json.Unmarshal([]byte("\"passed\""), &ref), ref.Get() == TestStatePassed)
+	fmt.Println(json.Unmarshal([]byte("\"xxx\""), &ref))
+
+	// Parse string into the concrete enum type
+	state, ok := enum.ParseAs[*TestState](TestStates, "passed")
+	fmt.Println(state == TestStatePassed, ok)
+
 	// Parse string into enum
 	fmt.Println(ParseTestState("passed"))
 	fmt.Println(ParseTestState("xxx"))
@@ -67,8 +77,11 @@ func Example() {
 	// false
 	// [34 112 97 115 115 101 100 34] <nil>
 	// passed
+	// <nil> true
+	// enum: unknown value 'xxx' for '*enum_test.TestState'
+	// true true
 	// passed
 	// <nil>
 	// passed
-	// recovered from panic: enum: 'xxx' not found
+	// recovered from panic: enum: unknown value 'xxx' for '*enum_test.TestState'
 }
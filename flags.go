@@ -0,0 +1,362 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strings"
+)
+
+// flagsSeparator separates the members in the text form of Flags.
+const flagsSeparator = "|"
+
+// Flags is a combination of members of the set bound to E.
+// Each member is stored as a bit of a 64 bits mask:
+//   - the bit set with WithBit if any,
+//   - otherwise the value of the member for int enums, which must be a power of two,
+//   - otherwise the position of the member in the set.
+//
+// The bitmasks of string enums depend on the declaration order:
+// inserting or reordering members changes the meaning of the stored bitmasks,
+// unless the members declare their bit with WithBit.
+// The zero value is the empty combination.
+//
+// Flags are encoded as a JSON array of member values, or as a packed integer for int enums,
+// as "read|write" text and as an integer bitmask in databases.
+//
+// Example:
+//
+//	Permissions = enum.NewSet[string](
+//		PermissionRead,
+//		PermissionWrite,
+//	).Bind()
+//	f := enum.NewFlags(PermissionRead, PermissionWrite)
+//	f.Contains(PermissionWrite) // true
+//	f.String() // "read|write"
+type Flags[E comparable] struct {
+	// The bits of the members
+	mask uint64
+}
+
+// NewFlags creates new flags with the given members.
+// It panics if the type is not bound or if a member is not in the bound set.
+func NewFlags[E comparable](members ...E) Flags[E] {
+	f, err := TryNewFlags(members...)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// TryNewFlags creates new flags with the given members.
+// It returns an error if the type is not bound or if a member is not in the bound set.
+func TryNewFlags[E comparable](members ...E) (Flags[E], error) {
+	var f Flags[E]
+	b, err := bindingOf[E]()
+	if err != nil {
+		return f, err
+	}
+	for _, member := range members {
+		if err := f.add(b, member); err != nil {
+			return Flags[E]{}, err
+		}
+	}
+	return f, nil
+}
+
+// ParseFlags parses the "read|write" text form of flags.
+// Members are matched by value, then by name.
+// An empty text is parsed as empty flags.
+func ParseFlags[E comparable](text string) (Flags[E], error) {
+	var f Flags[E]
+	err := f.UnmarshalText([]byte(text))
+	return f, err
+}
+
+// Union returns the flags with the members of both flags.
+func (f Flags[E]) Union(other Flags[E]) Flags[E] {
+	return Flags[E]{f.mask | other.mask}
+}
+
+// Intersect returns the flags with the members present in both flags.
+func (f Flags[E]) Intersect(other Flags[E]) Flags[E] {
+	return Flags[E]{f.mask & other.mask}
+}
+
+// Difference returns the flags with the members that are not in the other flags.
+func (f Flags[E]) Difference(other Flags[E]) Flags[E] {
+	return Flags[E]{f.mask &^ other.mask}
+}
+
+// Contains returns true if the member is in the flags.
+func (f Flags[E]) Contains(member E) bool {
+	b, err := bindingOf[E]()
+	if err != nil {
+		return false
+	}
+	pos, err := b.position(member)
+	if err != nil {
+		return false
+	}
+	bit, err := b.bit(pos)
+	if err != nil {
+		return false
+	}
+	return f.mask&bit != 0
+}
+
+// IsEmpty returns true if the flags have no member.
+func (f Flags[E]) IsEmpty() bool {
+	return f.mask == 0
+}
+
+// Len returns the number of members in the flags.
+func (f Flags[E]) Len() int {
+	return bits.OnesCount64(f.mask)
+}
+
+// Bits returns the bitmask of the flags.
+func (f Flags[E]) Bits() uint64 {
+	return f.mask
+}
+
+// Members returns the members of the flags in the set order.
+func (f Flags[E]) Members() []E {
+	members := []E{}
+	if f.mask == 0 {
+		return members
+	}
+	b, err := bindingOf[E]()
+	if err != nil {
+		return members
+	}
+	for i := 0; i < b.size(); i++ {
+		if bit, err := b.bit(i); err == nil && f.mask&bit != 0 {
+			members = append(members, b.member(i).(E))
+		}
+	}
+	return members
+}
+
+// String returns the "read|write" text form of the flags.
+func (f Flags[E]) String() string {
+	text, err := f.MarshalText()
+	if err != nil {
+		return fmt.Sprintf("%v", f.Members())
+	}
+	return string(text)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Flags of int enums are encoded as a packed integer,
+// other flags as an array of member values.
+func (f Flags[E]) MarshalJSON() ([]byte, error) {
+	if b, err := bindingOf[E](); err == nil && b.packed() {
+		return json.Marshal(f.mask)
+	}
+	return json.Marshal(f.Members())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts an array of member values or an integer bitmask.
+// JSON null resets the flags to the zero value.
+func (f *Flags[E]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = Flags[E]{}
+		return nil
+	}
+	b, err := bindingOf[E]()
+	if err != nil {
+		return err
+	}
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		var mask uint64
+		if json.Unmarshal(data, &mask) != nil {
+			return err
+		}
+		return f.setBits(b, mask)
+	}
+	var result Flags[E]
+	for _, value := range values {
+		member, err := b.resolveJSON(value)
+		if err != nil {
+			return err
+		}
+		if err := result.add(b, member); err != nil {
+			return err
+		}
+	}
+	*f = result
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Members are encoded with their own MarshalText and joined with "|".
+func (f Flags[E]) MarshalText() ([]byte, error) {
+	members := f.Members()
+	parts := make([]string, len(members))
+	for i, member := range members {
+		text, err := memberText(member)
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = text
+	}
+	return []byte(strings.Join(parts, flagsSeparator)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Members are separated by "|" and matched by value, then by name.
+func (f *Flags[E]) UnmarshalText(text []byte) error {
+	return f.parseList(string(text), flagsSeparator)
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts an integer bitmask, a text array such as "{read,write}"
+// or the "read|write" text form. NULL resets the flags to the zero value.
+func (f *Flags[E]) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*f = Flags[E]{}
+		return nil
+	case int64:
+		b, err := bindingOf[E]()
+		if err != nil {
+			return err
+		}
+		return f.setBits(b, uint64(v))
+	case []byte:
+		return f.scanText(string(v))
+	case string:
+		return f.scanText(v)
+	}
+	return &ConversionError{Value: value, Type: reflect.TypeOf(f).Elem(), Err: errUnsupportedType}
+}
+
+// Value implements the driver.Valuer interface.
+// Flags are stored as an integer bitmask.
+func (f Flags[E]) Value() (driver.Value, error) {
+	return int64(f.mask), nil
+}
+
+// scanText parses a text array or the "read|write" text form.
+func (f *Flags[E]) scanText(text string) error {
+	if strings.HasPrefix(text, "{") && strings.HasSuffix(text, "}") {
+		return f.parseList(text[1:len(text)-1], ",")
+	}
+	return f.parseList(text, flagsSeparator)
+}
+
+// parseList sets the flags to the members of the list separated by sep.
+func (f *Flags[E]) parseList(list, sep string) error {
+	b, err := bindingOf[E]()
+	if err != nil {
+		return err
+	}
+	members, err := resolveList(b, list, sep)
+	if err != nil {
+		return err
+	}
+	var result Flags[E]
+	for _, member := range members {
+		if err := result.add(b, member); err != nil {
+			return err
+		}
+	}
+	*f = result
+	return nil
+}
+
+// add sets the bit of the member.
+func (f *Flags[E]) add(b binding, member any) error {
+	pos, err := b.position(member)
+	if err != nil {
+		return err
+	}
+	bit, err := b.bit(pos)
+	if err != nil {
+		return err
+	}
+	f.mask |= bit
+	return nil
+}
+
+// setBits sets the flags to the given bitmask.
+// It returns an UnknownValueError if a bit does not match a member.
+func (f *Flags[E]) setBits(b binding, mask uint64) error {
+	var known uint64
+	for i := 0; i < b.size(); i++ {
+		if bit, err := b.bit(i); err == nil {
+			known |= bit
+		}
+	}
+	if mask&^known != 0 {
+		return &UnknownValueError{Value: mask, Type: reflect.TypeOf((*E)(nil)).Elem()}
+	}
+	f.mask = mask
+	return nil
+}
+
+// buildBits sets the flag bits of the members.
+// Members without valid bit are rejected when used as flags.
+// It returns a DuplicateBitError if two members have the same bit.
+func (s *Set[T]) buildBits() error {
+	s.bits = make([]uint64, len(s.members))
+	owners := make(map[uint64]int, len(s.members))
+	for i, e := range s.members {
+		if bitted, ok := e.(interface{ Bit() (int, bool) }); ok {
+			if bit, ok := bitted.Bit(); ok {
+				if bit >= 0 && bit < 64 {
+					s.bits[i] = 1 << bit
+				}
+				continue
+			}
+		}
+		switch v := reflect.ValueOf(e.GetValue()); {
+		case v.CanInt():
+			if val := v.Int(); val > 0 && val&(val-1) == 0 {
+				s.bits[i] = uint64(val)
+			}
+		case v.CanUint():
+			if val := v.Uint(); val > 0 && val&(val-1) == 0 {
+				s.bits[i] = val
+			}
+		case i < 64:
+			s.bits[i] = 1 << i
+		}
+	}
+	for i, bit := range s.bits {
+		if bit == 0 {
+			continue
+		}
+		if j, ok := owners[bit]; ok {
+			return &DuplicateBitError{
+				Bit:  bits.TrailingZeros64(bit),
+				A:    s.members[j],
+				B:    s.members[i],
+				Type: getEnummerType(s.members[i]),
+			}
+		}
+		owners[bit] = i
+	}
+	return nil
+}
+
+// bit implements the binding interface.
+// It returns an ErrInvalidBit error if the member has no valid bit.
+func (s *Set[T]) bit(i int) (uint64, error) {
+	if s.bits[i] == 0 {
+		return 0, fmt.Errorf("%w: '%v': declare its bit from 0 to 63 with WithBit", ErrInvalidBit, s.members[i])
+	}
+	return s.bits[i], nil
+}
+
+// packed implements the binding interface.
+// Flags of int enums are packed integers.
+func (s *Set[T]) packed() bool {
+	v := reflect.ValueOf(s.members[0].GetValue())
+	return v.CanInt() || v.CanUint()
+}
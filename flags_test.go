@@ -0,0 +1,313 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test type with a bound string enum used as flags
+type TestPermission struct {
+	Enum[string]
+}
+
+var (
+	TestPermissionRead   = &TestPermission{New("read", WithName("Read"))}
+	TestPermissionWrite  = &TestPermission{New("write", WithName("Write"))}
+	TestPermissionDelete = &TestPermission{New("delete", WithName("Delete"))}
+	TestPermissions      = NewSet[string](
+		TestPermissionRead,
+		TestPermissionWrite,
+		TestPermissionDelete,
+	).Bind()
+)
+
+func TestNewFlags(t *testing.T) {
+	f := NewFlags(TestPermissionDelete, TestPermissionRead)
+	require.Equal(t, uint64(0b101), f.Bits())
+	require.Equal(t, 2, f.Len())
+	require.Equal(t, []*TestPermission{TestPermissionRead, TestPermissionDelete}, f.Members())
+
+	require.True(t, NewFlags[*TestPermission]().IsEmpty())
+	require.Equal(t, []*TestPermission{}, Flags[*TestPermission]{}.Members())
+
+	_, err := TryNewFlags(&TestPermission{New("admin")})
+	require.ErrorIs(t, err, ErrNotInList)
+	_, err = TryNewFlags(&TestRefUnbound{})
+	require.ErrorIs(t, err, ErrNotBound)
+	require.Panics(t, func() { NewFlags(&TestPermission{New("admin")}) })
+}
+
+func TestFlags_Operations(t *testing.T) {
+	rw := NewFlags(TestPermissionRead, TestPermissionWrite)
+	wd := NewFlags(TestPermissionWrite, TestPermissionDelete)
+
+	require.Equal(t, NewFlags(TestPermissionRead, TestPermissionWrite, TestPermissionDelete), rw.Union(wd))
+	require.Equal(t, NewFlags(TestPermissionWrite), rw.Intersect(wd))
+	require.Equal(t, NewFlags(TestPermissionRead), rw.Difference(wd))
+
+	require.True(t, rw.Contains(TestPermissionRead))
+	require.False(t, rw.Contains(TestPermissionDelete))
+	require.False(t, rw.Contains(nil))
+	require.False(t, rw.Contains(&TestPermission{New("admin")}))
+}
+
+// Test type with int flags
+type TestBit struct {
+	Enum[int]
+}
+
+var (
+	TestBitNone  = &TestBit{New(0)}
+	TestBitRead  = &TestBit{New(1, WithName("Read"))}
+	TestBitWrite = &TestBit{New(2, WithName("Write"))}
+	TestBitAdmin = &TestBit{New(3, WithName("Admin"), WithBit(7))}
+	TestBits     = NewSet[int](TestBitNone, TestBitAdmin, TestBitWrite, TestBitRead).Bind()
+)
+
+// Test type with string flags declaring their bits
+type TestFeature struct {
+	Enum[string]
+}
+
+var (
+	TestFeatureBeta  = &TestFeature{New("beta", WithBit(1))}
+	TestFeatureAlpha = &TestFeature{New("alpha", WithBit(0))}
+	TestFeatures     = NewSet[string](TestFeatureBeta, TestFeatureAlpha).Bind()
+)
+
+func TestFlags_Bits(t *testing.T) {
+	// Int members are stored as their value or their explicit bit
+	f := NewFlags(TestBitRead, TestBitWrite, TestBitAdmin)
+	require.Equal(t, uint64(1<<7|0b11), f.Bits())
+	require.Equal(t, []*TestBit{TestBitAdmin, TestBitWrite, TestBitRead}, f.Members())
+	require.True(t, f.Contains(TestBitAdmin))
+	require.False(t, f.Contains(TestBitNone))
+
+	_, err := TryNewFlags(TestBitNone)
+	require.ErrorIs(t, err, ErrInvalidBit)
+
+	var got Flags[*TestBit]
+	require.NoError(t, got.Scan(int64(0b10)))
+	require.Equal(t, NewFlags(TestBitWrite), got)
+	require.ErrorIs(t, got.Scan(int64(0b100)), ErrUnknownValue)
+
+	// String members are stored as their explicit bit
+	require.Equal(t, uint64(0b01), NewFlags(TestFeatureAlpha).Bits())
+	require.Equal(t, uint64(0b10), NewFlags(TestFeatureBeta).Bits())
+
+	// Other string members are stored as their position
+	require.Equal(t, uint64(0b10), NewFlags(TestPermissionWrite).Bits())
+}
+
+func TestSet_DuplicateBit(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []Enummer[string]
+		bit     int
+	}{
+		{
+			name: "position and explicit bit",
+			members: []Enummer[string]{
+				&TestTypeString{New("a")},
+				&TestTypeString{New("b", WithBit(0))},
+			},
+			bit: 0,
+		},
+		{
+			name: "explicit bits",
+			members: []Enummer[string]{
+				&TestTypeString{New("a", WithBit(3))},
+				&TestTypeString{New("b", WithBit(3))},
+			},
+			bit: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := TryNewSet(tt.members...)
+			require.ErrorIs(t, err, ErrDuplicateBit)
+			var bitErr *DuplicateBitError
+			require.ErrorAs(t, err, &bitErr)
+			require.Equal(t, tt.bit, bitErr.Bit)
+			require.Same(t, tt.members[0], bitErr.A)
+			require.Same(t, tt.members[1], bitErr.B)
+		})
+	}
+
+	// Int values and explicit bits
+	_, err := TryNewSet[int](&TestTypeInt{New(2)}, &TestTypeInt{New(3, WithBit(1))})
+	require.ErrorIs(t, err, ErrDuplicateBit)
+	require.Panics(t, func() { NewSet[int](&TestTypeInt{New(4)}, &TestTypeInt{New(5, WithBit(2))}) })
+}
+
+func TestFlags_JSON(t *testing.T) {
+	type composite struct {
+		Permissions Flags[*TestPermission] `json:"permissions"`
+	}
+
+	got, err := json.Marshal(composite{NewFlags(TestPermissionWrite, TestPermissionRead)})
+	require.NoError(t, err)
+	require.Equal(t, "{\"permissions\":[\"read\",\"write\"]}", string(got))
+
+	got, err = json.Marshal(composite{})
+	require.NoError(t, err)
+	require.Equal(t, "{\"permissions\":[]}", string(got))
+
+	tests := []struct {
+		name    string
+		data    string
+		want    Flags[*TestPermission]
+		wantErr error
+	}{
+		{
+			name: "array",
+			data: "{\"permissions\":[\"delete\",\"read\"]}",
+			want: NewFlags(TestPermissionRead, TestPermissionDelete),
+		},
+		{
+			name: "bitmask",
+			data: "{\"permissions\":3}",
+			want: NewFlags(TestPermissionRead, TestPermissionWrite),
+		},
+		{
+			name: "null",
+			data: "{\"permissions\":null}",
+			want: Flags[*TestPermission]{},
+		},
+		{
+			name:    "unknown value",
+			data:    "{\"permissions\":[\"admin\"]}",
+			wantErr: ErrUnknownValue,
+		},
+		{
+			name:    "unknown bit",
+			data:    "{\"permissions\":8}",
+			wantErr: ErrUnknownValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := composite{NewFlags(TestPermissionWrite)}
+			err := json.Unmarshal([]byte(tt.data), &c)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c.Permissions)
+		})
+	}
+
+	var f Flags[*TestPermission]
+	require.Error(t, json.Unmarshal([]byte("\"read\""), &f))
+
+	// Flags of int enums are encoded as a packed integer
+	got, err = json.Marshal(NewFlags(TestBitRead, TestBitAdmin))
+	require.NoError(t, err)
+	require.Equal(t, "129", string(got))
+	got, err = json.Marshal(Flags[*TestBit]{})
+	require.NoError(t, err)
+	require.Equal(t, "0", string(got))
+
+	var bits Flags[*TestBit]
+	require.NoError(t, json.Unmarshal([]byte("129"), &bits))
+	require.Equal(t, NewFlags(TestBitRead, TestBitAdmin), bits)
+	require.NoError(t, json.Unmarshal([]byte("[2, 1]"), &bits))
+	require.Equal(t, NewFlags(TestBitRead, TestBitWrite), bits)
+}
+
+func TestFlags_Text(t *testing.T) {
+	f := NewFlags(TestPermissionRead, TestPermissionWrite)
+	require.Equal(t, "read|write", f.String())
+	require.Equal(t, "", Flags[*TestPermission]{}.String())
+
+	got, err := ParseFlags[*TestPermission]("write | Delete")
+	require.NoError(t, err)
+	require.Equal(t, NewFlags(TestPermissionWrite, TestPermissionDelete), got)
+
+	got, err = ParseFlags[*TestPermission]("")
+	require.NoError(t, err)
+	require.True(t, got.IsEmpty())
+
+	_, err = ParseFlags[*TestPermission]("read|admin")
+	require.ErrorIs(t, err, ErrUnknownValue)
+	_, err = ParseFlags[*TestPermission]("read|")
+	require.ErrorIs(t, err, ErrUnknownValue)
+	_, err = ParseFlags[*TestRefUnbound]("read")
+	require.ErrorIs(t, err, ErrNotBound)
+
+	// Int members are encoded with their name
+	ints := NewFlags(TestRefOne, TestRefTwo)
+	require.Equal(t, "One|Two", ints.String())
+	got2, err := ParseFlags[*TestRefInt]("2|One")
+	require.NoError(t, err)
+	require.Equal(t, ints, got2)
+}
+
+func TestFlags_SQL(t *testing.T) {
+	value, err := NewFlags(TestPermissionRead, TestPermissionDelete).Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(0b101), value)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    Flags[*TestPermission]
+		wantErr error
+	}{
+		{
+			name:  "bitmask",
+			value: int64(0b110),
+			want:  NewFlags(TestPermissionWrite, TestPermissionDelete),
+		},
+		{
+			name:  "text array",
+			value: []byte("{read,\"delete\"}"),
+			want:  NewFlags(TestPermissionRead, TestPermissionDelete),
+		},
+		{
+			name:  "empty text array",
+			value: "{}",
+			want:  Flags[*TestPermission]{},
+		},
+		{
+			name:  "text",
+			value: "read|write",
+			want:  NewFlags(TestPermissionRead, TestPermissionWrite),
+		},
+		{
+			name:  "null",
+			value: nil,
+			want:  Flags[*TestPermission]{},
+		},
+		{
+			name:    "unknown bit",
+			value:   int64(0b1000),
+			wantErr: ErrUnknownValue,
+		},
+		{
+			name:    "unknown value",
+			value:   "{read,admin}",
+			wantErr: ErrUnknownValue,
+		},
+		{
+			name:    "unsupported type",
+			value:   1.5,
+			wantErr: ErrConversion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFlags(TestPermissionRead)
+			err := f.Scan(tt.value)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, f)
+		})
+	}
+}
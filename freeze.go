@@ -0,0 +1,85 @@
+package enum
+
+import "reflect"
+
+// frozenState is the state shared by a frozen member and its copies.
+type frozenState[T Underlying] struct {
+	// The address of the frozen enum: copies have another address
+	enum *Enum[T]
+	// The type of the members
+	typ reflect.Type
+}
+
+// Freeze freezes the members of the set and returns the set.
+// Bind freezes the members of bound sets: Freeze is only needed
+// for the sets that are not bound.
+// Decoding another value into a frozen member returns a FrozenError
+// instead of overwriting the member shared by all its users.
+// Decoding cannot swap a pointer field to another member:
+// declare the fields decoded from JSON, text or SQL as Ref
+// to resolve them to the members instead.
+// Copies of the members are not frozen.
+//
+// The state is stored on the members without lock:
+// Freeze must be called when the set is declared,
+// before the members are used.
+//
+// Example:
+//
+//	TestStates = enum.NewSet[string](
+//		TestStatePassed,
+//		TestStateFailed,
+//	).Freeze()
+//	TestStatePassed.UnmarshalJSON([]byte(`"failed"`)) // FrozenError
+func (s *Set[T]) Freeze() *Set[T] {
+	for _, e := range s.members {
+		if f, ok := e.(interface{ enum() *Enum[T] }); ok {
+			if member := f.enum(); !member.Frozen() {
+				member.frozen = &frozenState[T]{enum: member, typ: getEnummerType(e)}
+			}
+		}
+	}
+	return s
+}
+
+// WithoutFreeze returns a copy of the set whose members are not frozen by Bind.
+// Decoding into a field pointing at a member then overwrites the member
+// shared by all its users: use it only if the members are never decoded into.
+// It panics if the set is bound.
+//
+// Example:
+//
+//	TestStates = enum.NewSet[string](
+//		TestStatePassed,
+//		TestStateFailed,
+//	).WithoutFreeze().Bind()
+func (s *Set[T]) WithoutFreeze() *Set[T] {
+	s.mustNotBeBound()
+	c := *s
+	c.mutable = true
+	return &c
+}
+
+// Frozen returns true if the enum is a frozen member.
+func (e *Enum[T]) Frozen() bool {
+	return e.frozen != nil && e.frozen.enum == e
+}
+
+// enum returns the enum embedded in a member.
+func (e *Enum[T]) enum() *Enum[T] {
+	return e
+}
+
+// set sets the value of the enum.
+// It returns a FrozenError if the enum is a frozen member
+// and the value is another value.
+func (e *Enum[T]) set(val T) error {
+	if val == e.val {
+		return nil
+	}
+	if e.Frozen() {
+		return &FrozenError{Value: e.val, Decoded: val, Type: e.frozen.typ}
+	}
+	e.val = val
+	return nil
+}
@@ -0,0 +1,119 @@
+package enum
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test type with members frozen by Bind
+type TestFrozenState struct {
+	Enum[string]
+}
+
+var (
+	TestFrozenStatePassed = &TestFrozenState{New("passed")}
+	TestFrozenStateFailed = &TestFrozenState{New("failed")}
+	TestFrozenStates      = NewSet[string](
+		TestFrozenStatePassed,
+		TestFrozenStateFailed,
+	).Bind()
+)
+
+// Test type with mutable bound members
+type TestMutableState struct {
+	Enum[string]
+}
+
+var (
+	TestMutableStatePassed = &TestMutableState{New("passed")}
+	TestMutableStates      = NewSet[string](TestMutableStatePassed).WithoutFreeze().Bind()
+)
+
+func TestSet_Freeze(t *testing.T) {
+	// Bound sets are frozen unless built with WithoutFreeze
+	require.True(t, TestFrozenStatePassed.Frozen())
+	require.True(t, TestRefHello.Frozen())
+	require.False(t, TestMutableStatePassed.Frozen())
+	require.Panics(t, func() { TestFrozenStates.WithoutFreeze() })
+
+	// Unbound sets are frozen with Freeze
+	unbound := &TestTypeString{New("passed")}
+	set := NewSet[string](unbound)
+	require.False(t, unbound.Frozen())
+	require.Same(t, set, set.Freeze())
+	require.True(t, unbound.Frozen())
+	require.Same(t, set, set.Freeze())
+	require.True(t, unbound.Frozen())
+
+	tests := []struct {
+		name   string
+		decode func(*TestFrozenState) error
+	}{
+		{
+			name:   "json",
+			decode: func(e *TestFrozenState) error { return json.Unmarshal([]byte("\"failed\""), e) },
+		},
+		{
+			name:   "text",
+			decode: func(e *TestFrozenState) error { return e.UnmarshalText([]byte("failed")) },
+		},
+		{
+			name:   "sql",
+			decode: func(e *TestFrozenState) error { return e.Scan([]byte("failed")) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.decode(TestFrozenStatePassed)
+			require.ErrorIs(t, err, ErrFrozen)
+			var frozenErr *FrozenError
+			require.ErrorAs(t, err, &frozenErr)
+			require.Equal(t, "passed", frozenErr.Value)
+			require.Equal(t, "failed", frozenErr.Decoded)
+			require.Equal(t, reflect.TypeOf(TestFrozenState{}), frozenErr.Type)
+			require.Equal(t, "passed", TestFrozenStatePassed.GetValue())
+
+			// Decoding the same value is allowed
+			require.NoError(t, tt.decode(TestFrozenStateFailed))
+
+			// Copies and new values are not frozen
+			e := *TestFrozenStatePassed
+			require.NoError(t, tt.decode(&e))
+			require.Equal(t, "failed", e.GetValue())
+			require.NoError(t, tt.decode(&TestFrozenState{}))
+		})
+	}
+}
+
+func TestSet_FreezeField(t *testing.T) {
+	type composite struct {
+		State *TestFrozenState      `json:"state"`
+		Ref   Ref[*TestFrozenState] `json:"ref"`
+	}
+
+	c := composite{State: TestFrozenStatePassed, Ref: NewRef(TestFrozenStatePassed)}
+	err := json.Unmarshal([]byte("{\"state\":\"failed\"}"), &c)
+	require.ErrorIs(t, err, ErrFrozen)
+	require.Equal(t, "passed", TestFrozenStatePassed.GetValue())
+
+	// Refs are resolved to the members
+	require.NoError(t, json.Unmarshal([]byte("{\"ref\":\"failed\"}"), &c))
+	require.Same(t, TestFrozenStateFailed, c.Ref.Get())
+	require.Equal(t, "passed", TestFrozenStatePassed.GetValue())
+}
+
+func TestSet_WithoutFreeze(t *testing.T) {
+	member := TestMutableStatePassed
+	t.Cleanup(func() { member.val = "passed" })
+
+	// Decoding overwrites the mutable member
+	c := struct {
+		State *TestMutableState `json:"state"`
+	}{State: member}
+	require.NoError(t, json.Unmarshal([]byte("{\"state\":\"failed\"}"), &c))
+	require.Same(t, member, c.State)
+	require.Equal(t, "failed", member.GetValue())
+}
@@ -0,0 +1,86 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GobEncode implements the gob.GobEncoder interface.
+// The enum is encoded as the text of its value.
+func (e Enum[T]) GobEncode() ([]byte, error) {
+	return []byte(fmt.Sprintf("%v", e.val)), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+// The value is converted like a database value.
+// A pointer field is decoded into a new value without the metadata
+// of the member, such as its name: only Ref fields resolve to the
+// declared members of the bound set.
+// It returns a FrozenError if the enum is a frozen member
+// and the data holds another value.
+func (e *Enum[T]) GobDecode(data []byte) error {
+	val, err := scanValue[T](data)
+	if err != nil {
+		return err
+	}
+	return e.set(val)
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+// The reference is encoded as the value of its member.
+// A reference without member nor default is encoded as empty data.
+func (r Ref[E]) GobEncode() ([]byte, error) {
+	var zero E
+	member := r.Get()
+	if member == zero {
+		return []byte{}, nil
+	}
+	m, ok := any(member).(interface{ GobEncode() ([]byte, error) })
+	if !ok {
+		return nil, fmt.Errorf("enum: '%T' does not implement gob.GobEncoder", member)
+	}
+	return m.GobEncode()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+// The reference resolves to the member of the bound set with the decoded value.
+// Empty data resolves to the member with an empty value, if any,
+// otherwise it resets the reference to the zero value.
+// It returns an UnknownValueError if the value is not a member of the bound set.
+func (r *Ref[E]) GobDecode(data []byte) error {
+	err := r.resolve(func(b binding) (any, error) {
+		return b.resolveSQL(data)
+	})
+	if len(data) == 0 && (errors.Is(err, ErrUnknownValue) || errors.Is(err, ErrConversion)) {
+		var zero E
+		r.member = zero
+		return nil
+	}
+	return err
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+// Flags are encoded as their "read|write" text form.
+func (f Flags[E]) GobEncode() ([]byte, error) {
+	return f.MarshalText()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (f *Flags[E]) GobDecode(data []byte) error {
+	return f.UnmarshalText(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+// The collection is encoded as comma separated member values.
+func (s SetOf[E]) GobEncode() ([]byte, error) {
+	text, err := s.text()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (s *SetOf[E]) GobDecode(data []byte) error {
+	return s.Scan(data)
+}
@@ -0,0 +1,97 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// gobRoundTrip encodes the value with gob and decodes it into the target.
+func gobRoundTrip(t *testing.T, value, target any) error {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(value))
+	return gob.NewDecoder(&buf).Decode(target)
+}
+
+func TestEnum_Gob(t *testing.T) {
+	type composite struct {
+		State   Ref[*TestRefInt]
+		Pointer *TestRefInt
+		Int     *TestTypeInt
+		Float   *Enum[float64]
+		Bool    *Enum[bool]
+		Absent  *TestLifecycle
+	}
+
+	var got composite
+	err := gobRoundTrip(t, composite{
+		State:   NewRef(TestRefTwo),
+		Pointer: TestRefTwo,
+		Int:     &TestTypeInt{New(-3)},
+		Float:   &Enum[float64]{val: 0.1},
+		Bool:    &Enum[bool]{val: true},
+	}, &got)
+	require.NoError(t, err)
+	require.Same(t, TestRefTwo, got.State.Get())
+	require.Equal(t, "Two", got.State.Get().String())
+
+	// Pointer fields are new values without the metadata of the member
+	require.NotSame(t, TestRefTwo, got.Pointer)
+	require.True(t, Equal[int](TestRefTwo, got.Pointer))
+	require.Equal(t, "2", got.Pointer.String())
+	require.Equal(t, -3, got.Int.GetValue())
+	require.Equal(t, 0.1, got.Float.GetValue())
+	require.True(t, got.Bool.GetValue())
+	require.Nil(t, got.Absent)
+
+	// Frozen members are not overwritten
+	frozenGot := struct{ State *TestFrozenState }{TestFrozenStatePassed}
+	err = gobRoundTrip(t, struct{ State *TestFrozenState }{TestFrozenStateFailed}, &frozenGot)
+	require.ErrorIs(t, err, ErrFrozen)
+	require.Equal(t, "passed", TestFrozenStatePassed.GetValue())
+
+	var intGot struct{ Int *TestTypeInt }
+	err = gobRoundTrip(t, struct{ Int *TestTypeString }{&TestTypeString{New("one")}}, &intGot)
+	require.ErrorIs(t, err, ErrConversion)
+}
+
+func TestRef_Gob(t *testing.T) {
+	type composite struct {
+		String  Ref[*TestRefString]
+		Int     Ref[*TestRefInt]
+		Default Ref[*TestRefDefault]
+	}
+
+	var got composite
+	require.NoError(t, gobRoundTrip(t, composite{String: NewRef(TestRefWorld), Int: NewRef(TestRefTwo)}, &got))
+	require.Same(t, TestRefWorld, got.String.Get())
+	require.Same(t, TestRefTwo, got.Int.Get())
+	require.Same(t, TestRefDefaultUnknown, got.Default.Get())
+
+	var ref Ref[*TestRefInt]
+	require.NoError(t, gobRoundTrip(t, Ref[*TestRefInt]{}, &ref))
+	require.Nil(t, ref.Get())
+
+	var unknown struct{ String Ref[*TestRefString] }
+	err := gobRoundTrip(t, struct{ String *TestRefString }{&TestRefString{Enum[string]{val: "xxx"}}}, &unknown)
+	require.ErrorIs(t, err, ErrUnknownValue)
+}
+
+func TestFlags_Gob(t *testing.T) {
+	type composite struct {
+		Permissions Flags[*TestPermission]
+		Allowed     SetOf[*TestPermission]
+	}
+
+	var got composite
+	err := gobRoundTrip(t, composite{
+		Permissions: NewFlags(TestPermissionDelete, TestPermissionRead),
+		Allowed:     NewSetOf(TestPermissionWrite),
+	}, &got)
+	require.NoError(t, err)
+	require.Equal(t, []*TestPermission{TestPermissionRead, TestPermissionDelete}, got.Permissions.Members())
+	require.Equal(t, []*TestPermission{TestPermissionWrite}, got.Allowed.Members())
+}
@@ -0,0 +1,139 @@
+package enum
+
+import "reflect"
+
+// valueIndex maps the values of an Enummer list
+// to their members and positions for constant time lookups.
+// The list must be checked with checkEnummerListType beforehand.
+// If a value is declared twice, the first member is kept.
+type valueIndex[T Underlying] struct {
+	// The first member of the list used for type checks
+	first Enummer[T]
+	// The dynamic type of the members
+	typ reflect.Type
+	// The members and list positions by value
+	entries map[T]indexEntry[T]
+}
+
+// indexEntry is a member, its list position and its rank.
+type indexEntry[T Underlying] struct {
+	member   Enummer[T]
+	position int
+	// The rank of the member if set, otherwise its position
+	// after the highest rank of the list
+	rank int
+}
+
+// newValueIndex creates a new index of the given list.
+func newValueIndex[T Underlying](list []Enummer[T]) *valueIndex[T] {
+	ix := &valueIndex[T]{
+		first:   list[0],
+		entries: make(map[T]indexEntry[T], len(list)),
+	}
+	ix.typ = reflect.TypeOf(list[0])
+	// Members without rank are ordered after the ranked members
+	after := 0
+	for _, e := range list {
+		if rank, ok := explicitRank(e); ok && rank >= after {
+			after = rank + 1
+		}
+	}
+	for i, e := range list {
+		if _, ok := ix.entries[e.GetValue()]; ok {
+			continue
+		}
+		ix.entries[e.GetValue()] = indexEntry[T]{member: e, position: i, rank: rankOf(e, after+i)}
+	}
+	return ix
+}
+
+// parse returns the member with the given value or nil.
+func (ix *valueIndex[T]) parse(val T) Enummer[T] {
+	return ix.entries[val].member
+}
+
+// tryParse returns the member with the given value.
+// It returns an UnknownValueError if the value is not found.
+func (ix *valueIndex[T]) tryParse(val T) (Enummer[T], error) {
+	entry, ok := ix.entries[val]
+	if !ok {
+		return nil, &UnknownValueError{Value: val, Type: reflect.TypeOf(ix.first)}
+	}
+	return entry.member, nil
+}
+
+// position returns the list position of the Enummer.
+// Enummers of the dynamic type of the list are found by comparing
+// their type to the cached type of the list, other Enummers have
+// their type checked like compareEnummerType.
+// It returns a TypeMismatchError if the Enummer is not of the list type
+// and a NotInListError if the Enummer is not in the list.
+func (ix *valueIndex[T]) position(e Enummer[T]) (int, error) {
+	entry, err := ix.entry(e)
+	return entry.position, err
+}
+
+// rank returns the rank of the Enummer if set, otherwise its list position.
+// It returns the same errors as position.
+func (ix *valueIndex[T]) rank(e Enummer[T]) (int, error) {
+	entry, err := ix.entry(e)
+	return entry.rank, err
+}
+
+// entry returns the index entry of the Enummer.
+// It returns the same errors as position.
+func (ix *valueIndex[T]) entry(e Enummer[T]) (indexEntry[T], error) {
+	if isNilEnummer(e) {
+		return indexEntry[T]{position: -1}, &NotInListError{Value: e, Type: getEnummerType(ix.first)}
+	}
+	if reflect.TypeOf(e) == ix.typ {
+		if entry, ok := ix.entries[e.GetValue()]; ok {
+			return entry, nil
+		}
+		return indexEntry[T]{position: -1}, &NotInListError{Value: e, Type: getEnummerType(e)}
+	}
+	// Enummers of another dynamic type may still be of the list type
+	// if one is a pointer to the other
+	entry, ok := ix.entries[e.GetValue()]
+	m := entry.member
+	if !ok {
+		m = ix.first
+	}
+	if !compareEnummerType(m, e) {
+		return indexEntry[T]{position: -1}, &TypeMismatchError{A: getEnummerType(e), B: getEnummerType(m)}
+	}
+	if !ok {
+		return indexEntry[T]{position: -1}, &NotInListError{Value: e, Type: getEnummerType(e)}
+	}
+	return entry, nil
+}
+
+// compare compares the ranks of the Enummers.
+// It returns an error if Enummers are not in the list.
+func (ix *valueIndex[T]) compare(a, b Enummer[T], cmp func(ai, bi int) bool) (bool, error) {
+	ai, err := ix.rank(a)
+	if err != nil {
+		return false, err
+	}
+	bi, err := ix.rank(b)
+	if err != nil {
+		return false, err
+	}
+	return cmp(ai, bi), nil
+}
+
+// rankOf returns the rank of the Enummer if set, otherwise the given default rank.
+func rankOf[T Underlying](e Enummer[T], def int) int {
+	if rank, ok := explicitRank(e); ok {
+		return rank
+	}
+	return def
+}
+
+// explicitRank returns the rank of the Enummer and true if it is set.
+func explicitRank[T Underlying](e Enummer[T]) (int, bool) {
+	if ranked, ok := e.(interface{ Rank() (int, bool) }); ok {
+		return ranked.Rank()
+	}
+	return 0, false
+}
@@ -0,0 +1,195 @@
+package enum
+
+import (
+	"maps"
+	"slices"
+)
+
+// metadata holds the optional description of an enum member.
+type metadata struct {
+	// The display name
+	name string
+	// The description
+	description string
+	// The arbitrary attributes
+	attributes map[string]any
+	// The alternative inputs parsed as the member
+	aliases []string
+	// To retire the member
+	deprecated bool
+	// The optional member replacing the deprecated member
+	replacement any
+	// The explicit rank used to order the member
+	rank int
+	// To order the member by rank
+	ranked bool
+	// The explicit bit of the member in Flags
+	bit int
+	// To store the member in Flags with the explicit bit
+	hasBit bool
+}
+
+// Option configures an enum member created with New.
+type Option func(*metadata)
+
+// WithName sets the display name of the enum member.
+// The name is returned by String instead of the value.
+func WithName(name string) Option {
+	return func(m *metadata) {
+		m.name = name
+	}
+}
+
+// WithDescription sets the description of the enum member.
+func WithDescription(description string) Option {
+	return func(m *metadata) {
+		m.description = description
+	}
+}
+
+// WithAttribute sets an arbitrary attribute of the enum member.
+// Setting the same key twice overwrites the previous value.
+func WithAttribute(key string, value any) Option {
+	return func(m *metadata) {
+		if m.attributes == nil {
+			m.attributes = make(map[string]any)
+		}
+		m.attributes[key] = value
+	}
+}
+
+// WithAliases adds alternative inputs parsed as the enum member by sets,
+// such as legacy spellings. The member is still marshaled with its value.
+func WithAliases(aliases ...string) Option {
+	return func(m *metadata) {
+		m.aliases = append(m.aliases, aliases...)
+	}
+}
+
+// WithDeprecated marks the enum member as deprecated.
+// Deprecated members are still parsed but are excluded from Set.Members.
+func WithDeprecated() Option {
+	return func(m *metadata) {
+		m.deprecated = true
+	}
+}
+
+// WithReplacement marks the enum member as deprecated
+// and replaced by the given member of the same set.
+// Sets with the ReplaceDeprecated parse option parse the member as its replacement.
+func WithReplacement(replacement any) Option {
+	return func(m *metadata) {
+		m.deprecated = true
+		m.replacement = replacement
+	}
+}
+
+// WithRank sets the explicit rank of the enum member.
+// Comparisons order the members by rank instead of list position,
+// so the order does not depend on the declaration order.
+// Members without rank are ordered after the ranked members.
+func WithRank(rank int) Option {
+	return func(m *metadata) {
+		m.rank = rank
+		m.ranked = true
+	}
+}
+
+// WithBit sets the explicit bit of the enum member in Flags, from 0 to 63.
+// The bitmasks of the flags do not depend on the declaration order
+// nor on the value of the member.
+func WithBit(bit int) Option {
+	return func(m *metadata) {
+		m.bit = bit
+		m.hasBit = true
+	}
+}
+
+// newMetadata returns the metadata configured by the options
+// or nil if there is no option.
+func newMetadata(opts []Option) *metadata {
+	if len(opts) == 0 {
+		return nil
+	}
+	m := &metadata{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Name returns the display name of the enum.
+// It returns an empty string if the name is not set.
+func (e Enum[T]) Name() string {
+	if e.meta == nil {
+		return ""
+	}
+	return e.meta.name
+}
+
+// Description returns the description of the enum.
+// It returns an empty string if the description is not set.
+func (e Enum[T]) Description() string {
+	if e.meta == nil {
+		return ""
+	}
+	return e.meta.description
+}
+
+// Attribute returns the attribute of the enum with the given key.
+// The boolean is false if the attribute is not set.
+func (e Enum[T]) Attribute(key string) (any, bool) {
+	if e.meta == nil {
+		return nil, false
+	}
+	value, ok := e.meta.attributes[key]
+	return value, ok
+}
+
+// Attributes returns a copy of the attributes of the enum.
+func (e Enum[T]) Attributes() map[string]any {
+	if e.meta == nil || e.meta.attributes == nil {
+		return map[string]any{}
+	}
+	return maps.Clone(e.meta.attributes)
+}
+
+// Aliases returns a copy of the aliases of the enum.
+func (e Enum[T]) Aliases() []string {
+	if e.meta == nil {
+		return nil
+	}
+	return slices.Clone(e.meta.aliases)
+}
+
+// Deprecated returns true if the enum is deprecated.
+func (e Enum[T]) Deprecated() bool {
+	return e.meta != nil && e.meta.deprecated
+}
+
+// Replacement returns the member replacing the deprecated enum.
+// It returns nil if the enum has no replacement.
+func (e Enum[T]) Replacement() any {
+	if e.meta == nil {
+		return nil
+	}
+	return e.meta.replacement
+}
+
+// Rank returns the explicit rank of the enum.
+// The boolean is false if the rank is not set.
+func (e Enum[T]) Rank() (int, bool) {
+	if e.meta == nil {
+		return 0, false
+	}
+	return e.meta.rank, e.meta.ranked
+}
+
+// Bit returns the explicit bit of the enum in Flags.
+// The boolean is false if the bit is not set.
+func (e Enum[T]) Bit() (int, bool) {
+	if e.meta == nil {
+		return 0, false
+	}
+	return e.meta.bit, e.meta.hasBit
+}
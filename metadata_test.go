@@ -0,0 +1,99 @@
+package enum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnum_Metadata(t *testing.T) {
+	tests := []struct {
+		name            string
+		enum            Enum[int]
+		wantString      string
+		wantName        string
+		wantDescription string
+		wantAttributes  map[string]any
+	}{
+		{
+			name:           "no option",
+			enum:           New(1),
+			wantString:     "1",
+			wantAttributes: map[string]any{},
+		},
+		{
+			name: "all options",
+			enum: New(1,
+				WithName("One"),
+				WithDescription("The first value"),
+				WithAttribute("color", "red"),
+				WithAttribute("weight", 10),
+			),
+			wantString:      "One",
+			wantName:        "One",
+			wantDescription: "The first value",
+			wantAttributes:  map[string]any{"color": "red", "weight": 10},
+		},
+		{
+			name:            "description only",
+			enum:            New(1, WithDescription("The first value")),
+			wantString:      "1",
+			wantDescription: "The first value",
+			wantAttributes:  map[string]any{},
+		},
+		{
+			name:           "attribute overwritten",
+			enum:           New(1, WithAttribute("color", "red"), WithAttribute("color", "blue")),
+			wantString:     "1",
+			wantAttributes: map[string]any{"color": "blue"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &TestTypeInt{tt.enum}
+			require.Equal(t, 1, e.GetValue())
+			require.Equal(t, tt.wantString, e.String())
+			require.Equal(t, tt.wantName, e.Name())
+			require.Equal(t, tt.wantDescription, e.Description())
+			require.Equal(t, tt.wantAttributes, e.Attributes())
+			for key, want := range tt.wantAttributes {
+				got, ok := e.Attribute(key)
+				require.True(t, ok)
+				require.Equal(t, want, got)
+			}
+			_, ok := e.Attribute("unknown")
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestEnum_AttributesCopy(t *testing.T) {
+	e := New(1, WithAttribute("color", "red"))
+
+	// Modifying the result must not alter the enum
+	attributes := e.Attributes()
+	attributes["color"] = "blue"
+	got, _ := e.Attribute("color")
+	require.Equal(t, "red", got)
+}
+
+func TestEnum_Aliases(t *testing.T) {
+	require.Nil(t, New("passed").Aliases())
+
+	e := New("passed", WithAliases("ok"), WithAliases("success", "green"))
+	aliases := e.Aliases()
+	require.Equal(t, []string{"ok", "success", "green"}, aliases)
+
+	// Modifying the result must not alter the enum
+	aliases[0] = "ko"
+	require.Equal(t, []string{"ok", "success", "green"}, e.Aliases())
+}
+
+func TestEnum_Rank(t *testing.T) {
+	_, ok := New(1).Rank()
+	require.False(t, ok)
+
+	rank, ok := New(1, WithRank(0)).Rank()
+	require.True(t, ok)
+	require.Equal(t, 0, rank)
+}
@@ -0,0 +1,206 @@
+package enum
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Sort sorts the list of members of the set in ascending order.
+// Members with the same rank keep their order.
+// It panics if the list has Enummers that are not members of the set.
+func (s *Set[T]) Sort(list []Enummer[T]) {
+	if err := s.TrySort(list); err != nil {
+		panic(err)
+	}
+}
+
+// TrySort is like Sort but returns an error instead of panicking.
+// The list is not modified on error.
+func (s *Set[T]) TrySort(list []Enummer[T]) error {
+	type ranked struct {
+		member Enummer[T]
+		rank   int
+	}
+	sorted := make([]ranked, len(list))
+	for i, e := range list {
+		rank, err := s.index.rank(e)
+		if err != nil {
+			return err
+		}
+		sorted[i] = ranked{e, rank}
+	}
+	slices.SortStableFunc(sorted, func(a, b ranked) int {
+		return cmp.Compare(a.rank, b.rank)
+	})
+	for i, r := range sorted {
+		list[i] = r.member
+	}
+	return nil
+}
+
+// Min returns the lowest member of the list.
+// It panics if the list is empty or has Enummers that are not members of the set.
+//
+// Example:
+//
+//	TestStates.Min(TestStateFailed, TestStatePassed) // TestStatePassed
+func (s *Set[T]) Min(list ...Enummer[T]) Enummer[T] {
+	e, err := s.TryMin(list...)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// TryMin is like Min but returns an error instead of panicking.
+func (s *Set[T]) TryMin(list ...Enummer[T]) (Enummer[T], error) {
+	return s.extremum(list, func(rank, best int) bool { return rank < best })
+}
+
+// Max returns the highest member of the list.
+// It panics if the list is empty or has Enummers that are not members of the set.
+//
+// Example:
+//
+//	TestStates.Max(TestStatePassed, TestStateFailed) // TestStateFailed
+func (s *Set[T]) Max(list ...Enummer[T]) Enummer[T] {
+	e, err := s.TryMax(list...)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// TryMax is like Max but returns an error instead of panicking.
+func (s *Set[T]) TryMax(list ...Enummer[T]) (Enummer[T], error) {
+	return s.extremum(list, func(rank, best int) bool { return rank > best })
+}
+
+// Between returns true if the Enummer is between lo and hi, inclusive.
+// It panics if Enummers are not members of the set.
+func (s *Set[T]) Between(e, lo, hi Enummer[T]) bool {
+	between, err := s.TryBetween(e, lo, hi)
+	if err != nil {
+		panic(err)
+	}
+	return between
+}
+
+// TryBetween is like Between but returns an error instead of panicking.
+func (s *Set[T]) TryBetween(e, lo, hi Enummer[T]) (bool, error) {
+	rank, err := s.index.rank(e)
+	if err != nil {
+		return false, err
+	}
+	loRank, err := s.index.rank(lo)
+	if err != nil {
+		return false, err
+	}
+	hiRank, err := s.index.rank(hi)
+	if err != nil {
+		return false, err
+	}
+	return loRank <= rank && rank <= hiRank, nil
+}
+
+// Range returns the members from one member to another, inclusive, in ascending order.
+// Deprecated members are excluded. It returns an empty list if from is higher than to.
+// It panics if Enummers are not members of the set.
+//
+// Example:
+//
+//	TestStates.Range(TestStatePassed, TestStateFailed) // [TestStatePassed TestStateSkipped TestStateFailed]
+func (s *Set[T]) Range(from, to Enummer[T]) []Enummer[T] {
+	members, err := s.TryRange(from, to)
+	if err != nil {
+		panic(err)
+	}
+	return members
+}
+
+// TryRange is like Range but returns an error instead of panicking.
+func (s *Set[T]) TryRange(from, to Enummer[T]) ([]Enummer[T], error) {
+	fromRank, err := s.index.rank(from)
+	if err != nil {
+		return nil, err
+	}
+	toRank, err := s.index.rank(to)
+	if err != nil {
+		return nil, err
+	}
+	members := []Enummer[T]{}
+	for _, e := range s.ordered {
+		if rank, _ := s.index.rank(e); fromRank <= rank && rank <= toRank {
+			members = append(members, e)
+		}
+	}
+	return members, nil
+}
+
+// Next returns the member following the Enummer in ascending order.
+// Deprecated members are skipped.
+// The boolean is false if the Enummer is the highest member,
+// is deprecated or is not a member of the set.
+func (s *Set[T]) Next(e Enummer[T]) (Enummer[T], bool) {
+	return s.step(e, 1)
+}
+
+// Prev returns the member preceding the Enummer in ascending order.
+// Deprecated members are skipped.
+// The boolean is false if the Enummer is the lowest member,
+// is deprecated or is not a member of the set.
+func (s *Set[T]) Prev(e Enummer[T]) (Enummer[T], bool) {
+	return s.step(e, -1)
+}
+
+// step returns the member at the given offset of the Enummer in ascending order.
+func (s *Set[T]) step(e Enummer[T], offset int) (Enummer[T], bool) {
+	pos, err := s.index.position(e)
+	if err != nil || s.order[pos] < 0 {
+		return nil, false
+	}
+	i := s.order[pos] + offset
+	if i < 0 || i >= len(s.ordered) {
+		return nil, false
+	}
+	return s.ordered[i], true
+}
+
+// extremum returns the member of the list with the best rank.
+// It returns an error if the list is empty or
+// has Enummers that are not members of the set.
+func (s *Set[T]) extremum(list []Enummer[T], better func(rank, best int) bool) (Enummer[T], error) {
+	if len(list) == 0 {
+		return nil, ErrEmptyList
+	}
+	var best Enummer[T]
+	bestRank := 0
+	for _, e := range list {
+		rank, err := s.index.rank(e)
+		if err != nil {
+			return nil, err
+		}
+		if best == nil || better(rank, bestRank) {
+			best, bestRank = e, rank
+		}
+	}
+	return best, nil
+}
+
+// buildOrder sorts the members that are not deprecated by rank.
+func (s *Set[T]) buildOrder() {
+	s.ordered = s.Members()
+	slices.SortStableFunc(s.ordered, func(a, b Enummer[T]) int {
+		ai, _ := s.index.rank(a)
+		bi, _ := s.index.rank(b)
+		return cmp.Compare(ai, bi)
+	})
+	s.order = make([]int, len(s.members))
+	for i := range s.order {
+		s.order[i] = -1
+	}
+	for i, e := range s.ordered {
+		pos, _ := s.index.position(e)
+		s.order[pos] = i
+	}
+}
@@ -0,0 +1,91 @@
+package enum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test members declared out of order with ranks
+var (
+	TestOrderLow      = &TestTypeInt{New(1, WithRank(10))}
+	TestOrderMedium   = &TestTypeInt{New(2, WithRank(20))}
+	TestOrderHigh     = &TestTypeInt{New(3, WithRank(30))}
+	TestOrderCritical = &TestTypeInt{New(4, WithRank(40))}
+	TestOrderLegacy   = &TestTypeInt{New(5, WithRank(25), WithDeprecated())}
+	TestOrders        = NewSet[int](
+		TestOrderHigh,
+		TestOrderLow,
+		TestOrderLegacy,
+		TestOrderCritical,
+		TestOrderMedium,
+	)
+)
+
+func TestSet_Sort(t *testing.T) {
+	list := []Enummer[int]{TestOrderCritical, TestOrderLow, TestOrderLegacy, TestOrderMedium, TestOrderLow}
+	TestOrders.Sort(list)
+	require.Equal(t, []Enummer[int]{TestOrderLow, TestOrderLow, TestOrderMedium, TestOrderLegacy, TestOrderCritical}, list)
+
+	list = []Enummer[int]{TestOrderHigh, &TestTypeInt{New(6)}}
+	require.ErrorIs(t, TestOrders.TrySort(list), ErrNotInList)
+	require.Equal(t, []Enummer[int]{TestOrderHigh, &TestTypeInt{New(6)}}, list)
+	require.Panics(t, func() { TestOrders.Sort(list) })
+}
+
+func TestSet_MinMax(t *testing.T) {
+	require.Same(t, TestOrderLow, TestOrders.Min(TestOrderHigh, TestOrderLow, TestOrderMedium))
+	require.Same(t, TestOrderHigh, TestOrders.Max(TestOrderLow, TestOrderHigh, TestOrderMedium))
+	require.Same(t, TestOrderLow, TestOrders.Max(TestOrderLow))
+
+	_, err := TestOrders.TryMin()
+	require.ErrorIs(t, err, ErrEmptyList)
+	_, err = TestOrders.TryMax(TestOrderLow, &TestTypeInt{New(6)})
+	require.ErrorIs(t, err, ErrNotInList)
+	_, err = TestOrders.TryMax(TestOrderLow, &Test2TypeInt{New(1)})
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	require.Panics(t, func() { TestOrders.Min() })
+	require.Panics(t, func() { TestOrders.Max() })
+}
+
+func TestSet_Between(t *testing.T) {
+	require.True(t, TestOrders.Between(TestOrderMedium, TestOrderLow, TestOrderHigh))
+	require.True(t, TestOrders.Between(TestOrderLow, TestOrderLow, TestOrderHigh))
+	require.True(t, TestOrders.Between(TestOrderHigh, TestOrderLow, TestOrderHigh))
+	require.False(t, TestOrders.Between(TestOrderCritical, TestOrderLow, TestOrderHigh))
+	require.False(t, TestOrders.Between(TestOrderMedium, TestOrderHigh, TestOrderLow))
+
+	_, err := TestOrders.TryBetween(TestOrderLow, nil, TestOrderHigh)
+	require.ErrorIs(t, err, ErrNotInList)
+	require.Panics(t, func() { TestOrders.Between(TestOrderLow, TestOrderLow, nil) })
+}
+
+func TestSet_Range(t *testing.T) {
+	require.Equal(t, []Enummer[int]{TestOrderMedium, TestOrderHigh, TestOrderCritical},
+		TestOrders.Range(TestOrderMedium, TestOrderCritical))
+	require.Equal(t, []Enummer[int]{TestOrderLow}, TestOrders.Range(TestOrderLow, TestOrderLow))
+	require.Equal(t, []Enummer[int]{}, TestOrders.Range(TestOrderHigh, TestOrderLow))
+
+	_, err := TestOrders.TryRange(TestOrderLow, &TestTypeInt{New(6)})
+	require.ErrorIs(t, err, ErrNotInList)
+	require.Panics(t, func() { TestOrders.Range(nil, TestOrderLow) })
+}
+
+func TestSet_NextPrev(t *testing.T) {
+	next, ok := TestOrders.Next(TestOrderMedium)
+	require.True(t, ok)
+	require.Same(t, TestOrderHigh, next)
+	_, ok = TestOrders.Next(TestOrderCritical)
+	require.False(t, ok)
+
+	prev, ok := TestOrders.Prev(TestOrderHigh)
+	require.True(t, ok)
+	require.Same(t, TestOrderMedium, prev)
+	_, ok = TestOrders.Prev(TestOrderLow)
+	require.False(t, ok)
+
+	_, ok = TestOrders.Next(TestOrderLegacy)
+	require.False(t, ok)
+	_, ok = TestOrders.Prev(&TestTypeInt{New(6)})
+	require.False(t, ok)
+}
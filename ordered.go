@@ -0,0 +1,143 @@
+package enum
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Check that Ordered implements Enummer.
+var _ Enummer[int] = (*Ordered[int])(nil)
+
+// Ordered is an Enum providing the comparison methods once its set is bound.
+// The comparisons use the order of the bound set: the methods panic
+// if the set is not bound, like the Set comparison methods
+// if the other Enummer is not a member of the set.
+// The Try methods return an error instead.
+//
+// The declared members and their copies are linked to the bound set.
+// Values decoded into a new pointer are not linked: the type embedding
+// them is unknown, so they cannot be told apart from the members of
+// another type and their methods return ErrNotBound.
+// Decode them into a Ref or into a copy of a member instead.
+//
+// Example:
+//
+//	type TestState struct {
+//		enum.Ordered[string]
+//	}
+//	TestStatePassed = &TestState{enum.NewOrdered("passed")}
+//	TestStateFailed = &TestState{enum.NewOrdered("failed")}
+//	TestStates = enum.NewSet[string](TestStatePassed, TestStateFailed).Bind()
+//	TestStatePassed.LessThan(TestStateFailed) // true
+type Ordered[T Underlying] struct {
+	Enum[T]
+	// The set linked when it is bound, shared by the copies of the member
+	link *orderedLink[T]
+}
+
+// orderedLink holds the set bound to an Ordered member.
+type orderedLink[T Underlying] struct {
+	set atomic.Pointer[Set[T]]
+}
+
+// NewOrdered creates a new ordered enum with the given value.
+// Options set the optional metadata of the enum.
+// The result must be embedded into a struct
+// and the members must be bound with Set.Bind.
+func NewOrdered[T Underlying](val T, opts ...Option) Ordered[T] {
+	return Ordered[T]{Enum: New(val, opts...), link: &orderedLink[T]{}}
+}
+
+// Equal returns true if the enum is equal to the other Enummer.
+// A nil Enummer is not equal to the enum.
+// It panics if the Enummer is not of the same type.
+func (o Ordered[T]) Equal(other Enummer[T]) bool {
+	equal, err := o.TryEqual(other)
+	if err != nil {
+		panic(err)
+	}
+	return equal
+}
+
+// TryEqual is like Equal but returns an error instead of panicking.
+func (o Ordered[T]) TryEqual(other Enummer[T]) (bool, error) {
+	if other == nil {
+		return false, nil
+	}
+	s, err := o.set()
+	if err != nil {
+		return false, err
+	}
+	member, ok := s.index.entries[o.val]
+	if !ok {
+		return false, &UnknownValueError{Value: o.val, Type: getEnummerType(other)}
+	}
+	return TryEqual(member.member, other)
+}
+
+// GreaterThan returns true if the enum is greater than the other Enummer.
+func (o Ordered[T]) GreaterThan(other Enummer[T]) bool {
+	return o.Compare(other) > 0
+}
+
+// GreaterThanOrEqual returns true if the enum is greater than or equal to the other Enummer.
+func (o Ordered[T]) GreaterThanOrEqual(other Enummer[T]) bool {
+	return o.Compare(other) >= 0
+}
+
+// LessThan returns true if the enum is less than the other Enummer.
+func (o Ordered[T]) LessThan(other Enummer[T]) bool {
+	return o.Compare(other) < 0
+}
+
+// LessThanOrEqual returns true if the enum is less than or equal to the other Enummer.
+func (o Ordered[T]) LessThanOrEqual(other Enummer[T]) bool {
+	return o.Compare(other) <= 0
+}
+
+// Compare returns -1 if the enum is lower than the other Enummer,
+// 0 if they have the same rank and +1 if it is higher.
+// It panics if the other Enummer is not a member of the bound set.
+func (o Ordered[T]) Compare(other Enummer[T]) int {
+	result, err := o.TryCompare(other)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// TryCompare is like Compare but returns an error instead of panicking.
+// It returns an UnknownValueError if the value of the enum
+// is not a member of the bound set, a TypeMismatchError if the other
+// Enummer is of another type and ErrNotBound if the enum is not linked.
+func (o Ordered[T]) TryCompare(other Enummer[T]) (int, error) {
+	s, err := o.set()
+	if err != nil {
+		return 0, err
+	}
+	member, ok := s.index.entries[o.val]
+	if !ok {
+		return 0, &UnknownValueError{Value: o.val, Type: getEnummerType(s.members[0])}
+	}
+	return s.TryCompare(member.member, other)
+}
+
+// bindOrdered links the member to the bound set.
+func (o Ordered[T]) bindOrdered(s *Set[T]) {
+	if o.link != nil {
+		o.link.set.Store(s)
+	}
+}
+
+// set returns the set linked to the enum.
+// The set of another type is never used:
+// the other Enummer is checked against the members of the linked set.
+// It returns ErrNotBound if the enum is not linked.
+func (o Ordered[T]) set() (*Set[T], error) {
+	if o.link != nil {
+		if s := o.link.set.Load(); s != nil {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: ordered enum '%v'", ErrNotBound, o.val)
+}
@@ -0,0 +1,139 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test type embedding an ordered enum
+type TestLevel struct {
+	Ordered[int]
+}
+
+var (
+	TestLevelLow    = &TestLevel{NewOrdered(1, WithName("Low"), WithRank(10))}
+	TestLevelHigh   = &TestLevel{NewOrdered(2, WithName("High"), WithRank(30))}
+	TestLevelMedium = &TestLevel{NewOrdered(3, WithName("Medium"), WithRank(20))}
+	TestLevels      = NewSet[int](
+		TestLevelLow,
+		TestLevelHigh,
+		TestLevelMedium,
+	).Bind()
+)
+
+func TestOrdered_Compare(t *testing.T) {
+	require.True(t, TestLevelLow.LessThan(TestLevelMedium))
+	require.True(t, TestLevelHigh.GreaterThan(TestLevelMedium))
+	require.True(t, TestLevelMedium.GreaterThanOrEqual(TestLevelMedium))
+	require.False(t, TestLevelMedium.GreaterThanOrEqual(TestLevelHigh))
+	require.True(t, TestLevelMedium.LessThanOrEqual(TestLevelMedium))
+	require.False(t, TestLevelHigh.LessThanOrEqual(TestLevelLow))
+	require.Equal(t, -1, TestLevelLow.Compare(TestLevelHigh))
+	require.Equal(t, 0, TestLevelLow.Compare(TestLevelLow))
+	require.Equal(t, 1, TestLevelHigh.Compare(TestLevelLow))
+
+	// Copies share the link to the set
+	low := *TestLevelLow
+	require.True(t, low.LessThan(TestLevelHigh))
+
+	require.Panics(t, func() { TestLevelLow.LessThan(&TestLevel{NewOrdered(4)}) })
+	require.Panics(t, func() { TestLevelLow.LessThan(TestDriverOne) })
+	require.Panics(t, func() { TestLevelLow.LessThan(nil) })
+}
+
+func TestOrdered_Equal(t *testing.T) {
+	require.True(t, TestLevelLow.Equal(TestLevelLow))
+	require.False(t, TestLevelLow.Equal(TestLevelHigh))
+	require.False(t, TestLevelLow.Equal(nil))
+	require.False(t, TestLevelLow.Equal((*TestLevel)(nil)))
+	require.Panics(t, func() { TestLevelLow.Equal(TestDriverOne) })
+}
+
+// Test type embedding an ordered enum without bound set
+type TestUnboundLevel struct {
+	Ordered[int]
+}
+
+func TestOrdered_Unbound(t *testing.T) {
+	unbound := &TestUnboundLevel{NewOrdered(1)}
+	other := &TestUnboundLevel{NewOrdered(2)}
+	NewSet[int](unbound, other)
+	require.PanicsWithError(t, "enum: type not bound to a set: ordered enum '1'", func() {
+		unbound.LessThan(other)
+	})
+
+	_, err := unbound.TryCompare(other)
+	require.ErrorIs(t, err, ErrNotBound)
+}
+
+func TestOrdered_Decoded(t *testing.T) {
+	// Values decoded into a copy of a member share its link
+	decoded := *TestLevelLow
+	require.NoError(t, json.Unmarshal([]byte("3"), &decoded))
+	require.Equal(t, 1, TestLevelLow.GetValue())
+	require.True(t, decoded.LessThan(TestLevelHigh))
+	require.True(t, decoded.Equal(TestLevelMedium))
+	require.False(t, decoded.Equal(TestLevelLow))
+	require.Equal(t, 0, decoded.Compare(&decoded))
+
+	// Unknown values are returned as errors by the Try methods
+	require.NoError(t, json.Unmarshal([]byte("4"), &decoded))
+	_, err := decoded.TryCompare(TestLevelHigh)
+	require.ErrorIs(t, err, ErrUnknownValue)
+	_, err = decoded.TryEqual(TestLevelHigh)
+	require.ErrorIs(t, err, ErrUnknownValue)
+	require.Panics(t, func() { decoded.LessThan(TestLevelHigh) })
+
+	// References resolve to the members
+	var ref Ref[*TestLevel]
+	require.NoError(t, json.Unmarshal([]byte("1"), &ref))
+	require.True(t, ref.Get().LessThan(TestLevelHigh))
+
+	// Values decoded into a new pointer are not linked
+	var unlinked *TestLevel
+	require.NoError(t, json.Unmarshal([]byte("1"), &unlinked))
+	_, err = unlinked.TryEqual(TestLevelLow)
+	require.ErrorIs(t, err, ErrNotBound)
+	_, err = unlinked.TryCompare(TestLevelHigh)
+	require.ErrorIs(t, err, ErrNotBound)
+}
+
+// Test type embedding an ordered enum bound to another set
+type TestOtherLevel struct {
+	Ordered[int]
+}
+
+var (
+	TestOtherLevelLow  = &TestOtherLevel{NewOrdered(1)}
+	TestOtherLevelHigh = &TestOtherLevel{NewOrdered(2)}
+	TestOtherLevels    = NewSet[int](TestOtherLevelLow, TestOtherLevelHigh).Bind()
+)
+
+func TestOrdered_OtherType(t *testing.T) {
+	decoded := *TestLevelLow
+	require.NoError(t, json.Unmarshal([]byte("1"), &decoded))
+	var unlinked *TestLevel
+	require.NoError(t, json.Unmarshal([]byte("1"), &unlinked))
+
+	tests := []struct {
+		name    string
+		level   *TestLevel
+		wantErr error
+	}{
+		{name: "member", level: TestLevelLow, wantErr: ErrTypeMismatch},
+		{name: "copy", level: &decoded, wantErr: ErrTypeMismatch},
+		{name: "unlinked", level: unlinked, wantErr: ErrNotBound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, err := tt.level.TryEqual(TestOtherLevelLow)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.False(t, equal)
+			_, err = tt.level.TryCompare(TestOtherLevelHigh)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Panics(t, func() { tt.level.Equal(TestOtherLevelLow) })
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package enum
+
+import (
+	"reflect"
+	"strings"
+)
+
+// parseConfig holds the parse options of a set.
+type parseConfig struct {
+	// To match the values, names and aliases case-insensitively
+	foldCase bool
+	// To ignore the leading and trailing white spaces
+	trimSpace bool
+	// To parse the deprecated members as their replacement
+	replaceDeprecated bool
+}
+
+// ParseOption configures how a set parses values.
+type ParseOption func(*parseConfig)
+
+// FoldCase makes the set match string values, names and aliases case-insensitively.
+func FoldCase() ParseOption {
+	return func(c *parseConfig) {
+		c.foldCase = true
+	}
+}
+
+// TrimSpace makes the set ignore the leading and trailing white spaces of string values.
+func TrimSpace() ParseOption {
+	return func(c *parseConfig) {
+		c.trimSpace = true
+	}
+}
+
+// ReplaceDeprecated makes the set parse the deprecated members as their replacement.
+// Deprecated members without replacement are still parsed as themselves.
+func ReplaceDeprecated() ParseOption {
+	return func(c *parseConfig) {
+		c.replaceDeprecated = true
+	}
+}
+
+// normalize returns the string as matched by the set.
+func (c parseConfig) normalize(s string) string {
+	if c.trimSpace {
+		s = strings.TrimSpace(s)
+	}
+	if c.foldCase {
+		s = strings.ToLower(s)
+	}
+	return s
+}
+
+// normalizes returns true if string values are normalized.
+func (c parseConfig) normalizes() bool {
+	return c.foldCase || c.trimSpace
+}
+
+// WithParseOptions returns a copy of the set parsing values with the given options.
+// Parsed values always resolve to the canonical members,
+// which are marshaled with their declared value.
+// It panics with a DuplicateValueError if two members match the same normalized value
+// and if the set is bound.
+//
+// Example:
+//
+//	TestStates = enum.NewSet[string](
+//		TestStatePassed,
+//		TestStateFailed,
+//	).WithParseOptions(enum.FoldCase(), enum.TrimSpace()).Bind()
+//	TestStates.Parse(" Passed ") // TestStatePassed
+func (s *Set[T]) WithParseOptions(opts ...ParseOption) *Set[T] {
+	s.mustNotBeBound()
+	c := *s
+	for _, opt := range opts {
+		opt(&c.config)
+	}
+	if err := c.buildLookup(); err != nil {
+		panic(err)
+	}
+	return &c
+}
+
+// buildLookup indexes the members by normalized name and alias.
+// The normalized values of string members are indexed as aliases
+// when they are normalized.
+// It returns a DuplicateValueError if an alias matches another member.
+func (s *Set[T]) buildLookup() error {
+	s.names = make(map[string]Enummer[T])
+	s.aliases = make(map[string]Enummer[T])
+	for _, e := range s.members {
+		if named, ok := e.(interface{ Name() string }); ok && named.Name() != "" {
+			key := s.config.normalize(named.Name())
+			if _, ok := s.names[key]; !ok {
+				s.names[key] = e
+			}
+		}
+
+		var keys []string
+		if str, ok := stringValue(e.GetValue()); ok && s.config.normalizes() {
+			keys = append(keys, str)
+		}
+		if aliased, ok := e.(interface{ Aliases() []string }); ok {
+			for _, alias := range aliased.Aliases() {
+				// An alias must not be the value of another member
+				if val, err := scanValue[T](alias); err == nil {
+					if m := s.index.parse(val); m != nil && m != e {
+						return &DuplicateValueError{Value: alias, Type: reflect.TypeOf(e)}
+					}
+				}
+				keys = append(keys, alias)
+			}
+		}
+		for _, key := range keys {
+			key = s.config.normalize(key)
+			if m, ok := s.aliases[key]; ok && m != e {
+				return &DuplicateValueError{Value: key, Type: reflect.TypeOf(e)}
+			}
+			s.aliases[key] = e
+		}
+	}
+	return nil
+}
+
+// lookup returns the member matching the value or nil.
+// Deprecated members are handled according to the set configuration.
+func (s *Set[T]) lookup(val T) Enummer[T] {
+	return s.deprecate(s.find(val))
+}
+
+// lookupText returns the member matching the text or nil.
+// Deprecated members are handled according to the set configuration.
+func (s *Set[T]) lookupText(text string) Enummer[T] {
+	return s.deprecate(s.findText(text))
+}
+
+// find returns the member matching the value or nil.
+// Members are matched by value, then by normalized value and alias.
+func (s *Set[T]) find(val T) Enummer[T] {
+	if e := s.index.parse(val); e != nil || len(s.aliases) == 0 {
+		return e
+	}
+	str, ok := stringValue(val)
+	if !ok {
+		return nil
+	}
+	return s.aliases[s.config.normalize(str)]
+}
+
+// findText returns the member matching the text or nil.
+// Members are matched by value, then by name and alias.
+func (s *Set[T]) findText(text string) Enummer[T] {
+	if val, err := scanValue[T](text); err == nil {
+		if e := s.find(val); e != nil {
+			return e
+		}
+	}
+	key := s.config.normalize(text)
+	if key != text {
+		if val, err := scanValue[T](key); err == nil {
+			if e := s.index.parse(val); e != nil {
+				return e
+			}
+		}
+	}
+	if e, ok := s.names[key]; ok {
+		return e
+	}
+	return s.aliases[key]
+}
+
+// stringValue returns the string of the value if its kind is string.
+func stringValue[T Underlying](val T) (string, bool) {
+	v := reflect.ValueOf(val)
+	if v.Kind() != reflect.String {
+		return "", false
+	}
+	return v.String(), true
+}
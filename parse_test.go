@@ -0,0 +1,105 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test type with a bound string enum parsed with options
+type TestParseState struct {
+	Enum[string]
+}
+
+var (
+	TestParseStatePassed = &TestParseState{New("passed", WithName("Passed"), WithAliases("ok", "Success"))}
+	TestParseStateFailed = &TestParseState{New("FAILED", WithAliases("ko"))}
+	TestParseStates      = NewSet[string](
+		TestParseStatePassed,
+		TestParseStateFailed,
+	).WithParseOptions(FoldCase(), TrimSpace()).Bind()
+)
+
+func TestSet_ParseOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  Enummer[string]
+	}{
+		{name: "value", value: "passed", want: TestParseStatePassed},
+		{name: "upper case value", value: "PASSED", want: TestParseStatePassed},
+		{name: "lower case value", value: "failed", want: TestParseStateFailed},
+		{name: "spaces", value: " passed\t", want: TestParseStatePassed},
+		{name: "alias", value: "ok", want: TestParseStatePassed},
+		{name: "folded alias", value: " SUCCESS ", want: TestParseStatePassed},
+		{name: "other alias", value: "Ko", want: TestParseStateFailed},
+		{name: "unknown", value: "skipped", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, TestParseStates.Parse(tt.value))
+			got, err := TestParseStates.ParseText([]byte(tt.value))
+			if tt.want == nil {
+				require.ErrorIs(t, err, ErrUnknownValue)
+				return
+			}
+			require.NoError(t, err)
+			require.Same(t, tt.want, got)
+		})
+	}
+
+	// Names are matched by text
+	got, err := TestParseStates.ParseText([]byte(" PASSED "))
+	require.NoError(t, err)
+	require.Same(t, TestParseStatePassed, got)
+}
+
+func TestSet_Aliases(t *testing.T) {
+	passed := &TestTypeString{New("passed", WithAliases("ok"))}
+	failed := &TestTypeString{New("failed")}
+
+	// Aliases are matched exactly without parse options
+	set := NewSet[string](passed, failed)
+	require.Same(t, passed, set.Parse("ok"))
+	require.Nil(t, set.Parse("OK"))
+	require.Nil(t, set.Parse(" passed"))
+	_, err := set.TryParse("OK")
+	require.ErrorIs(t, err, ErrUnknownValue)
+
+	// Aliases matching another member are rejected
+	_, err = TryNewSet[string](passed, &TestTypeString{New("failed", WithAliases("ok"))})
+	require.ErrorIs(t, err, ErrDuplicateValue)
+	_, err = TryNewSet[string](passed, &TestTypeString{New("ok")})
+	require.ErrorIs(t, err, ErrDuplicateValue)
+	require.Panics(t, func() {
+		NewSet[string](passed, &TestTypeString{New("PASSED")}).WithParseOptions(FoldCase())
+	})
+
+	// Int enums match aliases by text
+	one := &TestTypeInt{New(1, WithAliases("first"))}
+	ints := NewSet[int](one, &TestTypeInt{New(2)}).WithParseOptions(TrimSpace())
+	got, err := ints.ParseText([]byte("first"))
+	require.NoError(t, err)
+	require.Same(t, one, got)
+	got, err = ints.ParseText([]byte(" 1 "))
+	require.NoError(t, err)
+	require.Same(t, one, got)
+}
+
+func TestRef_ParseOptions(t *testing.T) {
+	var ref Ref[*TestParseState]
+	require.NoError(t, json.Unmarshal([]byte("\" OK \""), &ref))
+	require.Same(t, TestParseStatePassed, ref.Get())
+
+	// The canonical value is marshaled
+	got, err := json.Marshal(ref)
+	require.NoError(t, err)
+	require.Equal(t, "\"passed\"", string(got))
+
+	require.NoError(t, ref.Scan([]byte("failed")))
+	require.Same(t, TestParseStateFailed, ref.Get())
+	value, err := ref.Value()
+	require.NoError(t, err)
+	require.Equal(t, "FAILED", value)
+}
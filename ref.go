@@ -0,0 +1,147 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Ref holds a reference to a canonical enum member.
+// Decoding a Ref never allocates a new member: it resolves to the member
+// declared in the set bound to E and rejects unknown values.
+// The zero value holds no member and is encoded as null,
+// or resolves to the default member if the bound set has one.
+//
+// Example:
+//
+//	type Test struct {
+//		State enum.Ref[*TestState] `json:"state"`
+//	}
+//	json.Unmarshal([]byte(`{"state":"passed"}`), &test)
+//	test.State.Get() == TestStatePassed // true
+type Ref[E comparable] struct {
+	// The canonical member
+	member E
+}
+
+// NewRef creates a new reference to the given member.
+func NewRef[E comparable](member E) Ref[E] {
+	return Ref[E]{member}
+}
+
+// Get returns the referenced member.
+// If the reference holds no member, it returns
+// the default member of the bound set, if any.
+func (r Ref[E]) Get() E {
+	var zero E
+	if r.member != zero {
+		return r.member
+	}
+	b, err := bindingOf[E]()
+	if err != nil {
+		return zero
+	}
+	if def, ok := b.defaultMember().(E); ok {
+		return def
+	}
+	return zero
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r Ref[E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Get())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// JSON null resets the reference to the zero value,
+// which resolves to the default member if the bound set has one.
+func (r *Ref[E]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		var zero E
+		r.member = zero
+		return nil
+	}
+	return r.resolve(func(b binding) (any, error) {
+		return b.resolveJSON(data)
+	})
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// A reference without member nor default is encoded as an empty text.
+func (r Ref[E]) MarshalText() ([]byte, error) {
+	var zero E
+	member := r.Get()
+	if member == zero {
+		return []byte{}, nil
+	}
+	text, err := memberText(member)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text is matched against the values, then the names of the members.
+// An empty text resolves to the member with an empty value, if any,
+// otherwise it resets the reference to the zero value like MarshalText encodes it.
+func (r *Ref[E]) UnmarshalText(text []byte) error {
+	err := r.resolve(func(b binding) (any, error) {
+		return b.resolveText(text)
+	})
+	if len(text) == 0 && (errors.Is(err, ErrUnknownValue) || errors.Is(err, ErrConversion)) {
+		var zero E
+		r.member = zero
+		return nil
+	}
+	return err
+}
+
+// Scan implements the sql.Scanner interface.
+// NULL resets the reference to the zero value,
+// which resolves to the default member if the bound set has one.
+func (r *Ref[E]) Scan(value interface{}) error {
+	if value == nil {
+		var zero E
+		r.member = zero
+		return nil
+	}
+	return r.resolve(func(b binding) (any, error) {
+		return b.resolveSQL(value)
+	})
+}
+
+// Value implements the driver.Valuer interface.
+// A reference without member nor default is stored as NULL.
+func (r Ref[E]) Value() (driver.Value, error) {
+	var zero E
+	member := r.Get()
+	if member == zero {
+		return nil, nil
+	}
+	v, ok := any(member).(driver.Valuer)
+	if !ok {
+		return nil, fmt.Errorf("enum: '%T' does not implement driver.Valuer", member)
+	}
+	return v.Value()
+}
+
+// resolve sets the reference to the member
+// resolved by the set bound to E.
+func (r *Ref[E]) resolve(fn func(binding) (any, error)) error {
+	b, err := bindingOf[E]()
+	if err != nil {
+		return err
+	}
+	member, err := fn(b)
+	if err != nil {
+		return err
+	}
+	m, ok := member.(E)
+	if !ok {
+		return fmt.Errorf("enum: cannot convert '%T' to '%T'", member, r.member)
+	}
+	r.member = m
+	return nil
+}
@@ -0,0 +1,210 @@
+package enum
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test type with a bound string enum
+type TestRefString struct {
+	Enum[string]
+}
+
+// Test type with an unbound string enum
+type TestRefUnbound struct {
+	Enum[string]
+}
+
+var (
+	TestRefHello = &TestRefString{Enum[string]{val: "hello"}}
+	TestRefWorld = &TestRefString{Enum[string]{val: "world"}}
+	TestRefs     = NewSet[string](TestRefHello, TestRefWorld).Bind()
+)
+
+// Test type with a bound int enum with names
+type TestRefInt struct {
+	Enum[int]
+}
+
+var (
+	TestRefOne  = &TestRefInt{New(1, WithName("One"))}
+	TestRefTwo  = &TestRefInt{New(2, WithName("Two"))}
+	TestRefInts = NewSet[int](TestRefOne, TestRefTwo).Bind()
+)
+
+// Test type with a bound string enum with a default member
+type TestRefDefault struct {
+	Enum[string]
+}
+
+var (
+	TestRefDefaultUnknown = &TestRefDefault{Enum[string]{val: "unknown"}}
+	TestRefDefaultKnown   = &TestRefDefault{Enum[string]{val: "known"}}
+	TestRefDefaults       = NewSet[string](
+		TestRefDefaultUnknown,
+		TestRefDefaultKnown,
+	).WithDefault(TestRefDefaultUnknown).Bind()
+)
+
+// Composite type with a reference to a string enum
+type TestCompositeRef struct {
+	TestType Ref[*TestRefString] `json:"test_type"`
+}
+
+func TestRef_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		ref  TestCompositeRef
+		want []byte
+	}{
+		{
+			name: "member",
+			ref:  TestCompositeRef{NewRef(TestRefHello)},
+			want: []byte("{\"test_type\":\"hello\"}"),
+		},
+		{
+			name: "zero",
+			ref:  TestCompositeRef{},
+			want: []byte("{\"test_type\":null}"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.ref)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRef_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    *TestRefString
+		wantErr error
+	}{
+		{
+			name: "member",
+			data: []byte("{\"test_type\":\"hello\"}"),
+			want: TestRefHello,
+		},
+		{
+			name: "null",
+			data: []byte("{\"test_type\":null}"),
+			want: nil,
+		},
+		{
+			name:    "unknown",
+			data:    []byte("{\"test_type\":\"foo\"}"),
+			wantErr: ErrUnknownValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TestCompositeRef{NewRef(TestRefWorld)}
+			err := json.Unmarshal(tt.data, &got)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Same(t, tt.want, got.TestType.Get())
+		})
+	}
+
+	// Canonical members must not be modified
+	require.Equal(t, "world", TestRefWorld.GetValue())
+}
+
+func TestRef_UnmarshalJSONNotBound(t *testing.T) {
+	var got Ref[*TestRefUnbound]
+	err := json.Unmarshal([]byte("\"hello\""), &got)
+	require.ErrorIs(t, err, ErrNotBound)
+}
+
+func TestRef_Text(t *testing.T) {
+	// Round trip through the name
+	text, err := NewRef(TestRefTwo).MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "Two", string(text))
+	var got Ref[*TestRefInt]
+	require.NoError(t, got.UnmarshalText(text))
+	require.Same(t, TestRefTwo, got.Get())
+
+	// Zero value
+	text, err = Ref[*TestRefInt]{}.MarshalText()
+	require.NoError(t, err)
+	require.Empty(t, text)
+	require.NoError(t, got.UnmarshalText(text))
+	require.Equal(t, Ref[*TestRefInt]{}, got)
+	require.ErrorIs(t, got.UnmarshalText([]byte("Three")), ErrUnknownValue)
+
+	// Empty text resets the reference to the default member
+	d := NewRef(TestRefDefaultKnown)
+	require.NoError(t, d.UnmarshalText(nil))
+	require.Same(t, TestRefDefaultUnknown, d.Get())
+}
+
+func TestRef_TextMapKey(t *testing.T) {
+	data, err := json.Marshal(map[Ref[*TestRefString]]int{NewRef(TestRefHello): 1})
+	require.NoError(t, err)
+	require.Equal(t, "{\"hello\":1}", string(data))
+
+	var got map[Ref[*TestRefString]]int
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, map[Ref[*TestRefString]]int{NewRef(TestRefHello): 1}, got)
+	require.ErrorIs(t, json.Unmarshal([]byte("{\"foo\":1}"), &got), ErrUnknownValue)
+
+	// Zero values round-trip as an empty key
+	data, err = json.Marshal(map[Ref[*TestRefInt]]int{{}: 1})
+	require.NoError(t, err)
+	require.Equal(t, "{\"\":1}", string(data))
+	var zero map[Ref[*TestRefInt]]int
+	require.NoError(t, json.Unmarshal(data, &zero))
+	require.Equal(t, map[Ref[*TestRefInt]]int{{}: 1}, zero)
+}
+
+func TestRef_TextFlag(t *testing.T) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	var got Ref[*TestRefInt]
+	flags.TextVar(&got, "number", NewRef(TestRefOne), "usage")
+	require.Same(t, TestRefOne, got.Get())
+
+	require.NoError(t, flags.Parse([]string{"-number", "Two"}))
+	require.Same(t, TestRefTwo, got.Get())
+	flags.SetOutput(io.Discard)
+	require.Error(t, flags.Parse([]string{"-number", "Three"}))
+}
+
+func TestRef_Default(t *testing.T) {
+	type composite struct {
+		State Ref[*TestRefDefault] `json:"state"`
+	}
+
+	// Missing, null and NULL values resolve to the default member
+	var c composite
+	require.NoError(t, json.Unmarshal([]byte("{}"), &c))
+	require.Same(t, TestRefDefaultUnknown, c.State.Get())
+	c = composite{NewRef(TestRefDefaultKnown)}
+	require.NoError(t, json.Unmarshal([]byte("{\"state\":null}"), &c))
+	require.Same(t, TestRefDefaultUnknown, c.State.Get())
+	c = composite{NewRef(TestRefDefaultKnown)}
+	require.NoError(t, c.State.Scan(nil))
+	require.Same(t, TestRefDefaultUnknown, c.State.Get())
+
+	// The default member is encoded
+	got, err := json.Marshal(composite{})
+	require.NoError(t, err)
+	require.Equal(t, "{\"state\":\"unknown\"}", string(got))
+	text, err := Ref[*TestRefDefault]{}.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "unknown", string(text))
+	value, err := Ref[*TestRefDefault]{}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "unknown", value)
+}
@@ -0,0 +1,354 @@
+package enum
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+)
+
+// Set is an ordered list of enum members of the same type.
+// It is built once from the enum members and is the single
+// source of truth for parsing, membership and ordering.
+// Members are ordered by rank, then the members without rank by index.
+type Set[T Underlying] struct {
+	// The ordered list of members
+	members []Enummer[T]
+	// The members and positions by value
+	index *valueIndex[T]
+	// The members by normalized name
+	names map[string]Enummer[T]
+	// The members by normalized alias
+	aliases map[string]Enummer[T]
+	// The optional parse options
+	config parseConfig
+	// The replacements of the deprecated members by value, nil if not replaced
+	deprecated map[T]Enummer[T]
+	// The optional hook called when a deprecated member is parsed
+	hook func(deprecated, replacement Enummer[T])
+	// The members that are not deprecated sorted by rank
+	ordered []Enummer[T]
+	// The position in ordered by set position, -1 if deprecated
+	order []int
+	// The optional default member
+	def Enummer[T]
+	// The codecs by format name, nil to use the registered codecs
+	codecs map[string]Codec
+	// The flag bits by set position, 0 if the member has no bit
+	bits []uint64
+	// Whether Bind leaves the members mutable
+	mutable bool
+}
+
+// NewSet creates a new set with the given members.
+// The order of the members defines the order of the enum.
+// It panics if the list is empty, if the members are not
+// of the same type or if a value is declared twice.
+//
+// Example:
+//
+//	TestStates = enum.NewSet[string](
+//		TestStateUnknown,
+//		TestStatePassed,
+//		TestStateSkipped,
+//		TestStateFailed,
+//	)
+func NewSet[T Underlying](members ...Enummer[T]) *Set[T] {
+	s, err := TryNewSet(members...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// TryNewSet is like NewSet but returns an error instead of panicking.
+func TryNewSet[T Underlying](members ...Enummer[T]) (*Set[T], error) {
+	if err := checkEnummerListType(members); err != nil {
+		return nil, err
+	}
+	if err := checkEnummerListDuplicate(members); err != nil {
+		return nil, err
+	}
+	s := &Set[T]{
+		members: slices.Clone(members),
+		index:   newValueIndex(members),
+	}
+	if err := s.buildLookup(); err != nil {
+		return nil, err
+	}
+	if err := s.buildDeprecated(); err != nil {
+		return nil, err
+	}
+	s.buildOrder()
+	if err := s.buildBits(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// WithDefault returns a copy of the set with the given default member.
+// The default member is returned by ParseOrDefault and by
+// the Ref values holding no member once the set is bound.
+// It panics if the member is not in the set or if the set is bound.
+//
+// Example:
+//
+//	TestStates = enum.NewSet[string](
+//		TestStateUnknown,
+//		TestStatePassed,
+//	).WithDefault(TestStateUnknown).Bind()
+func (s *Set[T]) WithDefault(e Enummer[T]) *Set[T] {
+	s.mustNotBeBound()
+	i, err := s.TryIndex(e)
+	if err != nil {
+		panic(err)
+	}
+	c := *s
+	c.def = s.members[i]
+	return &c
+}
+
+// Default returns the default member of the set.
+// It returns nil if the set has no default member.
+func (s *Set[T]) Default() Enummer[T] {
+	return s.def
+}
+
+// Members returns a copy of the ordered list of members
+// that are not deprecated.
+func (s *Set[T]) Members() []Enummer[T] {
+	if len(s.deprecated) == 0 {
+		return slices.Clone(s.members)
+	}
+	members := make([]Enummer[T], 0, len(s.members)-len(s.deprecated))
+	for _, e := range s.members {
+		if _, ok := s.deprecated[e.GetValue()]; !ok {
+			members = append(members, e)
+		}
+	}
+	return members
+}
+
+// AllMembers returns a copy of the ordered list of members
+// including the deprecated members.
+func (s *Set[T]) AllMembers() []Enummer[T] {
+	return slices.Clone(s.members)
+}
+
+// Parse parses the given string/int into an Enummer.
+// String values are also matched against the aliases of the members
+// and normalized according to the parse options of the set.
+// If the string/int is not found, it returns nil.
+func (s *Set[T]) Parse(val T) Enummer[T] {
+	return s.lookup(val)
+}
+
+// MustParse parses the given string/int into an Enummer.
+// If the string/int is not found, it panics with an UnknownValueError.
+func (s *Set[T]) MustParse(val T) Enummer[T] {
+	e, err := s.TryParse(val)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// TryParse parses the given string/int into an Enummer.
+// If the string/int is not found, it returns an UnknownValueError.
+func (s *Set[T]) TryParse(val T) (Enummer[T], error) {
+	if e := s.lookup(val); e != nil {
+		return e, nil
+	}
+	return nil, &UnknownValueError{Value: val, Type: reflect.TypeOf(s.members[0])}
+}
+
+// ParseOrDefault parses the given string/int into an Enummer.
+// If the string/int is not found, it returns the default member,
+// or nil if the set has no default member.
+func (s *Set[T]) ParseOrDefault(val T) Enummer[T] {
+	if e := s.lookup(val); e != nil {
+		return e
+	}
+	return s.def
+}
+
+// ParseAs parses the given string/int into the concrete enum type E.
+// The boolean is false if the string/int is not found
+// or if the members of the set are not of type E.
+//
+// Example:
+//
+//	state, ok := enum.ParseAs[*TestState](TestStates, "passed")
+func ParseAs[E Enummer[T], T Underlying](s *Set[T], val T) (E, bool) {
+	e, err := TryParseAs[E](s, val)
+	return e, err == nil
+}
+
+// MustParseAs parses the given string/int into the concrete enum type E.
+// It panics if the string/int is not found
+// or if the members of the set are not of type E.
+func MustParseAs[E Enummer[T], T Underlying](s *Set[T], val T) E {
+	e, err := TryParseAs[E](s, val)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// TryParseAs parses the given string/int into the concrete enum type E.
+// It returns an UnknownValueError if the string/int is not found
+// and a TypeMismatchError if the members of the set are not of type E.
+func TryParseAs[E Enummer[T], T Underlying](s *Set[T], val T) (E, error) {
+	var zero E
+	e, err := s.TryParse(val)
+	if err != nil {
+		return zero, err
+	}
+	typed, ok := e.(E)
+	if !ok {
+		return zero, &TypeMismatchError{A: getEnummerType(zero), B: getEnummerType(e)}
+	}
+	return typed, nil
+}
+
+// Contains returns true if the Enummer is a member of the set.
+// The Enummer must be of the same type and have the same value as a member.
+func (s *Set[T]) Contains(e Enummer[T]) bool {
+	return s.Index(e) >= 0
+}
+
+// Index returns the position of the Enummer in the set.
+// It returns -1 if the Enummer is not a member of the set.
+func (s *Set[T]) Index(e Enummer[T]) int {
+	i, err := s.TryIndex(e)
+	if err != nil {
+		return -1
+	}
+	return i
+}
+
+// TryIndex returns the position of the Enummer in the set.
+// It returns a TypeMismatchError if the Enummer is not of the set type
+// and a NotInListError if the Enummer is not a member of the set.
+func (s *Set[T]) TryIndex(e Enummer[T]) (int, error) {
+	return s.index.position(e)
+}
+
+// GreaterThan returns true if the first Enummer is greater than the second Enummer.
+// It panics if Enummers are not members of the set.
+func (s *Set[T]) GreaterThan(a, b Enummer[T]) bool {
+	return s.mustCompare(a, b, s.TryGreaterThan)
+}
+
+// TryGreaterThan is like GreaterThan but returns an error instead of panicking.
+func (s *Set[T]) TryGreaterThan(a, b Enummer[T]) (bool, error) {
+	return s.tryCompare(a, b, func(ai, bi int) bool { return ai > bi })
+}
+
+// GreaterThanOrEqual returns true if the first Enummer is greater than or equal to the second Enummer.
+// It panics if Enummers are not members of the set.
+func (s *Set[T]) GreaterThanOrEqual(a, b Enummer[T]) bool {
+	return s.mustCompare(a, b, s.TryGreaterThanOrEqual)
+}
+
+// TryGreaterThanOrEqual is like GreaterThanOrEqual but returns an error instead of panicking.
+func (s *Set[T]) TryGreaterThanOrEqual(a, b Enummer[T]) (bool, error) {
+	return s.tryCompare(a, b, func(ai, bi int) bool { return ai >= bi })
+}
+
+// LessThan returns true if the first Enummer is less than the second Enummer.
+// It panics if Enummers are not members of the set.
+func (s *Set[T]) LessThan(a, b Enummer[T]) bool {
+	return s.mustCompare(a, b, s.TryLessThan)
+}
+
+// TryLessThan is like LessThan but returns an error instead of panicking.
+func (s *Set[T]) TryLessThan(a, b Enummer[T]) (bool, error) {
+	return s.tryCompare(a, b, func(ai, bi int) bool { return ai < bi })
+}
+
+// LessThanOrEqual returns true if the first Enummer is less than or equal to the second Enummer.
+// It panics if Enummers are not members of the set.
+func (s *Set[T]) LessThanOrEqual(a, b Enummer[T]) bool {
+	return s.mustCompare(a, b, s.TryLessThanOrEqual)
+}
+
+// TryLessThanOrEqual is like LessThanOrEqual but returns an error instead of panicking.
+func (s *Set[T]) TryLessThanOrEqual(a, b Enummer[T]) (bool, error) {
+	return s.tryCompare(a, b, func(ai, bi int) bool { return ai <= bi })
+}
+
+// Compare returns -1 if the first Enummer is lower than the second Enummer,
+// 0 if they have the same rank and +1 if it is higher.
+// It can be used with slices.SortFunc to sort members of the set.
+// It panics if Enummers are not members of the set.
+func (s *Set[T]) Compare(a, b Enummer[T]) int {
+	result, err := s.TryCompare(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// TryCompare is like Compare but returns an error instead of panicking.
+func (s *Set[T]) TryCompare(a, b Enummer[T]) (int, error) {
+	ai, err := s.index.rank(a)
+	if err != nil {
+		return 0, err
+	}
+	bi, err := s.index.rank(b)
+	if err != nil {
+		return 0, err
+	}
+	return cmp.Compare(ai, bi), nil
+}
+
+// WithStrictOrder checks that the set orders its members strictly
+// and returns the set: no two members may have the same rank.
+// Members without rank are ordered after the ranked members
+// and cannot have the same rank.
+// It panics with a DuplicateRankError if two members have the same rank.
+//
+// Example:
+//
+//	Priorities = enum.NewSet[int](
+//		PriorityHigh, // enum.WithRank(20)
+//		PriorityLow,  // enum.WithRank(10)
+//	).WithStrictOrder()
+func (s *Set[T]) WithStrictOrder() *Set[T] {
+	ranks := make(map[int]Enummer[T], len(s.members))
+	for _, e := range s.members {
+		rank, _ := s.index.rank(e)
+		if other, ok := ranks[rank]; ok {
+			panic(&DuplicateRankError{Rank: rank, A: other, B: e, Type: getEnummerType(e)})
+		}
+		ranks[rank] = e
+	}
+	return s
+}
+
+// tryCompare compares the ranks of the Enummers in the set.
+// It returns an error if Enummers are not members of the set.
+func (s *Set[T]) tryCompare(a, b Enummer[T], cmp func(ai, bi int) bool) (bool, error) {
+	return s.index.compare(a, b, cmp)
+}
+
+// mustCompare calls the compare function and panics on error.
+func (s *Set[T]) mustCompare(a, b Enummer[T], compare func(a, b Enummer[T]) (bool, error)) bool {
+	result, err := compare(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// checkEnummerListDuplicate returns an error if a value is declared twice in the list.
+func checkEnummerListDuplicate[T Underlying](list []Enummer[T]) error {
+	seen := make(map[T]struct{}, len(list))
+	for _, e := range list {
+		if _, ok := seen[e.GetValue()]; ok {
+			return &DuplicateValueError{Value: e.GetValue(), Type: reflect.TypeOf(e)}
+		}
+		seen[e.GetValue()] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package enum
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    []Enummer[int]
+		wantErr error
+	}{
+		{
+			name: "valid",
+			list: []Enummer[int]{&Enum[int]{val: 1}, &Enum[int]{val: 2}},
+		},
+		{
+			name:    "empty",
+			list:    []Enummer[int]{},
+			wantErr: ErrEmptyList,
+		},
+		{
+			name:    "two types",
+			list:    []Enummer[int]{&TestTypeInt{Enum[int]{val: 1}}, &Test2TypeInt{Enum[int]{val: 2}}},
+			wantErr: ErrTypeMismatch,
+		},
+		{
+			name:    "duplicate",
+			list:    []Enummer[int]{&Enum[int]{val: 1}, &Enum[int]{val: 1}},
+			wantErr: ErrDuplicateValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr != nil {
+				require.Panics(t, func() { NewSet(tt.list...) })
+				_, err := TryNewSet(tt.list...)
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.Equal(t, tt.list, NewSet(tt.list...).Members())
+			}
+		})
+	}
+}
+
+func TestSet_Members(t *testing.T) {
+	list := []Enummer[string]{&Enum[string]{val: "hello"}, &Enum[string]{val: "world"}}
+	set := NewSet(list...)
+
+	// Modifying the input or the result must not alter the set
+	list[0] = &Enum[string]{val: "foo"}
+	members := set.Members()
+	members[1] = &Enum[string]{val: "bar"}
+	require.Equal(t, []Enummer[string]{&Enum[string]{val: "hello"}, &Enum[string]{val: "world"}}, set.Members())
+}
+
+func TestSet_Parse(t *testing.T) {
+	hello := &Enum[string]{val: "hello"}
+	set := NewSet[string](hello, &Enum[string]{val: "world"})
+
+	require.Same(t, hello, set.Parse("hello"))
+	require.Nil(t, set.Parse("foo"))
+	require.Same(t, hello, set.MustParse("hello"))
+	require.Panics(t, func() { set.MustParse("foo") })
+
+	got, err := set.TryParse("hello")
+	require.NoError(t, err)
+	require.Same(t, hello, got)
+	_, err = set.TryParse("foo")
+	require.ErrorIs(t, err, ErrUnknownValue)
+}
+
+func TestSet_Default(t *testing.T) {
+	unknown := &TestTypeString{Enum[string]{val: ""}}
+	hello := &TestTypeString{Enum[string]{val: "hello"}}
+	set := NewSet[string](unknown, hello)
+	require.Nil(t, set.Default())
+	require.Nil(t, set.ParseOrDefault("foo"))
+
+	withDefault := set.WithDefault(&TestTypeString{Enum[string]{val: ""}})
+	require.Same(t, unknown, withDefault.Default())
+	require.Same(t, hello, withDefault.ParseOrDefault("hello"))
+	require.Same(t, unknown, withDefault.ParseOrDefault("foo"))
+
+	// The original set is not modified
+	require.Nil(t, set.Default())
+
+	require.Panics(t, func() { set.WithDefault(&TestTypeString{Enum[string]{val: "foo"}}) })
+	require.Panics(t, func() { set.WithDefault(nil) })
+
+	// Bound sets cannot be configured: the copies would not be bound
+	panicsWithSetBound := func(fn func()) {
+		t.Helper()
+		defer func() {
+			err, _ := recover().(error)
+			require.ErrorIs(t, err, ErrSetBound)
+		}()
+		fn()
+	}
+	panicsWithSetBound(func() { TestRefDefaults.WithDefault(TestRefDefaultKnown) })
+	panicsWithSetBound(func() { TestRefDefaults.WithParseOptions(FoldCase()) })
+	panicsWithSetBound(func() { TestRefDefaults.WithDeprecationHook(nil) })
+	panicsWithSetBound(func() { TestRefDefaults.WithCodecs(JSONCodec{}) })
+	require.Same(t, TestRefDefaultUnknown, TestRefDefaults.Default())
+}
+
+func TestSet_Nil(t *testing.T) {
+	set := NewSet[string](&TestTypeString{Enum[string]{val: "hello"}})
+	var nilString *TestTypeString
+
+	require.False(t, set.Contains(nilString))
+	_, err := set.TryIndex(nilString)
+	require.ErrorIs(t, err, ErrNotInList)
+	_, err = set.TryGreaterThan(nilString, nil)
+	require.ErrorIs(t, err, ErrNotInList)
+}
+
+func TestParseAs(t *testing.T) {
+	one := &TestTypeInt{Enum[int]{val: 1}}
+	set := NewSet[int](one, &TestTypeInt{Enum[int]{val: 2}})
+
+	got, ok := ParseAs[*TestTypeInt](set, 1)
+	require.True(t, ok)
+	require.Same(t, one, got)
+	got, ok = ParseAs[*TestTypeInt](set, 3)
+	require.False(t, ok)
+	require.Nil(t, got)
+
+	require.Same(t, one, MustParseAs[*TestTypeInt](set, 1))
+	require.Panics(t, func() { MustParseAs[*TestTypeInt](set, 3) })
+
+	got, err := TryParseAs[*TestTypeInt](set, 1)
+	require.NoError(t, err)
+	require.Same(t, one, got)
+	_, err = TryParseAs[*TestTypeInt](set, 3)
+	require.ErrorIs(t, err, ErrUnknownValue)
+	other, err := TryParseAs[*Test2TypeInt](set, 1)
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	require.Nil(t, other)
+}
+
+func TestSet_Index(t *testing.T) {
+	set := NewSet[int](&TestTypeInt{Enum[int]{val: 1}}, &TestTypeInt{Enum[int]{val: 2}})
+	tests := []struct {
+		name string
+		enum Enummer[int]
+		want int
+	}{
+		{
+			name: "first",
+			enum: &TestTypeInt{Enum[int]{val: 1}},
+			want: 0,
+		},
+		{
+			name: "second",
+			enum: &TestTypeInt{Enum[int]{val: 2}},
+			want: 1,
+		},
+		{
+			name: "not found",
+			enum: &TestTypeInt{Enum[int]{val: 3}},
+			want: -1,
+		},
+		{
+			name: "other type",
+			enum: &Test2TypeInt{Enum[int]{val: 1}},
+			want: -1,
+		},
+		{
+			name: "nil",
+			enum: nil,
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, set.Index(tt.enum))
+			require.Equal(t, tt.want >= 0, set.Contains(tt.enum))
+			i, err := set.TryIndex(tt.enum)
+			require.Equal(t, tt.want, i)
+			require.Equal(t, tt.want < 0, err != nil)
+		})
+	}
+}
+
+func TestSet_Compare(t *testing.T) {
+	set := NewSet[string](&Enum[string]{val: "hello"}, &Enum[string]{val: "world"})
+	hello, world := &Enum[string]{val: "hello"}, &Enum[string]{val: "world"}
+	tests := []struct {
+		name    string
+		compare func(a, b Enummer[string]) bool
+		try     func(a, b Enummer[string]) (bool, error)
+		lower   bool
+		equal   bool
+		greater bool
+	}{
+		{
+			name:    "greater than",
+			compare: set.GreaterThan,
+			try:     set.TryGreaterThan,
+			greater: true,
+		},
+		{
+			name:    "greater than or equal",
+			compare: set.GreaterThanOrEqual,
+			try:     set.TryGreaterThanOrEqual,
+			equal:   true,
+			greater: true,
+		},
+		{
+			name:    "less than",
+			compare: set.LessThan,
+			try:     set.TryLessThan,
+			lower:   true,
+		},
+		{
+			name:    "less than or equal",
+			compare: set.LessThanOrEqual,
+			try:     set.TryLessThanOrEqual,
+			lower:   true,
+			equal:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.lower, tt.compare(hello, world))
+			require.Equal(t, tt.equal, tt.compare(hello, hello))
+			require.Equal(t, tt.greater, tt.compare(world, hello))
+			require.Panics(t, func() { tt.compare(hello, &Enum[string]{val: "foo"}) })
+
+			got, err := tt.try(hello, world)
+			require.NoError(t, err)
+			require.Equal(t, tt.lower, got)
+			_, err = tt.try(hello, &Enum[string]{val: "foo"})
+			require.ErrorIs(t, err, ErrNotInList)
+			_, err = tt.try(hello, &TestTypeString{Enum[string]{val: "hello"}})
+			require.ErrorIs(t, err, ErrTypeMismatch)
+		})
+	}
+}
+
+func TestSet_Rank(t *testing.T) {
+	low := &TestTypeInt{New(1, WithRank(10))}
+	high := &TestTypeInt{New(2, WithRank(20))}
+	medium := &TestTypeInt{New(3, WithRank(15))}
+
+	// The order does not depend on the declaration order
+	for _, set := range []*Set[int]{NewSet[int](low, high, medium), NewSet[int](high, medium, low)} {
+		require.True(t, set.GreaterThan(high, medium))
+		require.True(t, set.LessThan(low, medium))
+		require.Equal(t, -1, set.Compare(low, high))
+		require.Equal(t, 0, set.Compare(medium, medium))
+		require.Equal(t, 1, set.Compare(medium, low))
+
+		members := set.Members()
+		slices.SortFunc(members, set.Compare)
+		require.Equal(t, []Enummer[int]{low, medium, high}, members)
+	}
+	require.True(t, GreaterThan([]Enummer[int]{high, low})(high, low))
+
+	_, err := NewSet[int](low, high).TryCompare(low, medium)
+	require.ErrorIs(t, err, ErrNotInList)
+	require.Panics(t, func() { NewSet[int](low, high).Compare(low, medium) })
+
+	// Members without rank are ordered after the ranked members, by position
+	unranked := &TestTypeInt{New(4)}
+	other := &TestTypeInt{New(6)}
+	set := NewSet[int](unranked, low, other)
+	require.True(t, set.GreaterThan(unranked, low))
+	require.True(t, set.LessThan(unranked, other))
+	require.Same(t, set, set.WithStrictOrder())
+
+	// Positions do not tie with ranks
+	a := &TestTypeInt{New(7, WithRank(2))}
+	b := &TestTypeInt{New(8)}
+	c := &TestTypeInt{New(9)}
+	set = NewSet[int](a, b, c)
+	require.Equal(t, -1, set.Compare(a, c))
+	require.Equal(t, -1, set.Compare(b, c))
+	require.True(t, LessThan([]Enummer[int]{a, b, c})(a, c))
+
+	// Equal ranks are rejected by strict sets
+	same := &TestTypeInt{New(5, WithRank(10))}
+	set = NewSet[int](low, same)
+	require.Equal(t, 0, set.Compare(low, same))
+	require.False(t, set.LessThan(low, same))
+	require.PanicsWithError(t, (&DuplicateRankError{Rank: 10, A: low, B: same, Type: reflect.TypeOf(TestTypeInt{})}).Error(),
+		func() { set.WithStrictOrder() })
+}
+
+func TestSet_Underlying(t *testing.T) {
+	// Unsigned enum
+	low, high := &Enum[TestCode]{val: 1}, &Enum[TestCode]{val: 200}
+	codes := NewSet[TestCode](low, high)
+	require.Same(t, high, codes.Parse(200))
+	require.True(t, codes.LessThan(low, high))
+	text, err := high.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "200", string(text))
+
+	// Bool enum
+	no, yes := &Enum[bool]{val: false}, &Enum[bool]{val: true}
+	answers := NewSet[bool](no, yes)
+	got, err := answers.ParseText([]byte("true"))
+	require.NoError(t, err)
+	require.Same(t, yes, got)
+	require.True(t, answers.GreaterThan(yes, no))
+
+	// Float enum
+	light, heavy := &Enum[TestWeight]{val: 0.5}, &Enum[TestWeight]{val: 10}
+	weights := NewSet[TestWeight](light, heavy)
+	weight, err := weights.ParseSQL(int64(10))
+	require.NoError(t, err)
+	require.Same(t, heavy, weight)
+}
@@ -0,0 +1,274 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"maps"
+	"reflect"
+	"strings"
+)
+
+// setOfSeparator separates the members in the database form of SetOf.
+const setOfSeparator = ","
+
+// SetOf is an unordered collection of members of the set bound to E.
+// Members are stored once and iterated in the set order.
+// Unlike Flags, the set bound to E can have any number of members.
+// The zero value is an empty collection ready to use.
+//
+// SetOf is a value type like Flags: copies of a collection do not share
+// their members, Add and Remove only modify the given collection.
+//
+// SetOf is encoded as a JSON array of member values
+// and as comma separated member values in databases.
+//
+// Example:
+//
+//	type Test struct {
+//		Allowed enum.SetOf[*TestState] `json:"allowed"`
+//	}
+//	allowed := enum.NewSetOf(TestStatePassed, TestStateSkipped)
+//	allowed.Has(TestStatePassed) // true
+type SetOf[E comparable] struct {
+	// The set positions of the members
+	positions map[int]struct{}
+}
+
+// NewSetOf creates a new collection with the given members.
+// It panics if the type is not bound or if a member is not in the bound set.
+func NewSetOf[E comparable](members ...E) SetOf[E] {
+	s, err := TryNewSetOf(members...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// TryNewSetOf creates a new collection with the given members.
+// It returns an error if the type is not bound or if a member is not in the bound set.
+func TryNewSetOf[E comparable](members ...E) (SetOf[E], error) {
+	var s SetOf[E]
+	err := s.TryAdd(members...)
+	return s, err
+}
+
+// Add adds the given members to the collection.
+// It panics if the type is not bound or if a member is not in the bound set.
+func (s *SetOf[E]) Add(members ...E) {
+	if err := s.TryAdd(members...); err != nil {
+		panic(err)
+	}
+}
+
+// TryAdd adds the given members to the collection.
+// It returns an error if the type is not bound or if a member is not in the bound set.
+// The collection is not modified on error.
+func (s *SetOf[E]) TryAdd(members ...E) error {
+	b, err := bindingOf[E]()
+	if err != nil {
+		return err
+	}
+	anys := make([]any, len(members))
+	for i, member := range members {
+		anys[i] = member
+	}
+	return s.add(b, anys)
+}
+
+// Remove removes the given members from the collection.
+// Members not in the collection are ignored.
+func (s *SetOf[E]) Remove(members ...E) {
+	if len(s.positions) == 0 {
+		return
+	}
+	b, err := bindingOf[E]()
+	if err != nil {
+		return
+	}
+	// Copy on write: the positions may be shared with copies of the collection
+	positions := maps.Clone(s.positions)
+	for _, member := range members {
+		if pos, err := b.position(member); err == nil {
+			delete(positions, pos)
+		}
+	}
+	s.positions = positions
+}
+
+// Has returns true if the member is in the collection.
+func (s SetOf[E]) Has(member E) bool {
+	if len(s.positions) == 0 {
+		return false
+	}
+	b, err := bindingOf[E]()
+	if err != nil {
+		return false
+	}
+	pos, err := b.position(member)
+	if err != nil {
+		return false
+	}
+	_, ok := s.positions[pos]
+	return ok
+}
+
+// Union returns a new collection with the members of both collections.
+func (s SetOf[E]) Union(other SetOf[E]) SetOf[E] {
+	result := SetOf[E]{positions: make(map[int]struct{}, len(s.positions)+len(other.positions))}
+	for pos := range s.positions {
+		result.positions[pos] = struct{}{}
+	}
+	for pos := range other.positions {
+		result.positions[pos] = struct{}{}
+	}
+	return result
+}
+
+// Intersect returns a new collection with the members present in both collections.
+func (s SetOf[E]) Intersect(other SetOf[E]) SetOf[E] {
+	result := SetOf[E]{positions: map[int]struct{}{}}
+	for pos := range s.positions {
+		if _, ok := other.positions[pos]; ok {
+			result.positions[pos] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Len returns the number of members in the collection.
+func (s SetOf[E]) Len() int {
+	return len(s.positions)
+}
+
+// Members returns the members of the collection in the set order.
+func (s SetOf[E]) Members() []E {
+	members := make([]E, 0, len(s.positions))
+	if len(s.positions) == 0 {
+		return members
+	}
+	b, err := bindingOf[E]()
+	if err != nil {
+		return members
+	}
+	for i := 0; i < b.size(); i++ {
+		if _, ok := s.positions[i]; ok {
+			members = append(members, b.member(i).(E))
+		}
+	}
+	return members
+}
+
+// String returns the comma separated text form of the members.
+func (s SetOf[E]) String() string {
+	text, err := s.text()
+	if err != nil {
+		return ""
+	}
+	return text
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The collection is encoded as an array of member values.
+func (s SetOf[E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Members())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It returns an UnknownValueError if a value is not a member of the bound set.
+// JSON null resets the collection to the zero value.
+func (s *SetOf[E]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = SetOf[E]{}
+		return nil
+	}
+	b, err := bindingOf[E]()
+	if err != nil {
+		return err
+	}
+	var values []json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	members := make([]any, len(values))
+	for i, value := range values {
+		if members[i], err = b.resolveJSON(value); err != nil {
+			return err
+		}
+	}
+	*s = SetOf[E]{}
+	return s.add(b, members)
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts comma separated member values or a JSON array.
+// NULL resets the collection to the zero value.
+func (s *SetOf[E]) Scan(value interface{}) error {
+	var text string
+	switch v := value.(type) {
+	case nil:
+		*s = SetOf[E]{}
+		return nil
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		return &ConversionError{Value: value, Type: reflect.TypeOf(s).Elem(), Err: errUnsupportedType}
+	}
+	if strings.HasPrefix(strings.TrimSpace(text), "[") {
+		return s.UnmarshalJSON([]byte(text))
+	}
+	b, err := bindingOf[E]()
+	if err != nil {
+		return err
+	}
+	members, err := resolveList(b, text, setOfSeparator)
+	if err != nil {
+		return err
+	}
+	*s = SetOf[E]{}
+	return s.add(b, members)
+}
+
+// Value implements the driver.Valuer interface.
+// The collection is stored as comma separated member values.
+func (s SetOf[E]) Value() (driver.Value, error) {
+	return s.text()
+}
+
+// text returns the members text joined with commas.
+func (s SetOf[E]) text() (string, error) {
+	members := s.Members()
+	parts := make([]string, len(members))
+	for i, member := range members {
+		text, err := memberText(member)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = text
+	}
+	return strings.Join(parts, setOfSeparator), nil
+}
+
+// add adds the members resolved by the binding.
+// The collection is not modified on error.
+func (s *SetOf[E]) add(b binding, members []any) error {
+	positions := make([]int, len(members))
+	for i, member := range members {
+		pos, err := b.position(member)
+		if err != nil {
+			return err
+		}
+		positions[i] = pos
+	}
+	// Copy on write: the positions may be shared with copies of the collection
+	result := make(map[int]struct{}, len(s.positions)+len(positions))
+	for pos := range s.positions {
+		result[pos] = struct{}{}
+	}
+	for _, pos := range positions {
+		result[pos] = struct{}{}
+	}
+	s.positions = result
+	return nil
+}
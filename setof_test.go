@@ -0,0 +1,172 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetOf_AddRemove(t *testing.T) {
+	var s SetOf[*TestPermission]
+	require.Equal(t, 0, s.Len())
+	require.False(t, s.Has(TestPermissionRead))
+
+	s.Add(TestPermissionDelete, TestPermissionRead, TestPermissionDelete)
+	require.Equal(t, 2, s.Len())
+	require.True(t, s.Has(TestPermissionRead))
+	require.False(t, s.Has(TestPermissionWrite))
+	require.False(t, s.Has(nil))
+	require.Equal(t, []*TestPermission{TestPermissionRead, TestPermissionDelete}, s.Members())
+
+	s.Remove(TestPermissionRead, TestPermissionWrite)
+	require.Equal(t, []*TestPermission{TestPermissionDelete}, s.Members())
+
+	err := s.TryAdd(TestPermissionWrite, &TestPermission{New("admin")})
+	require.ErrorIs(t, err, ErrNotInList)
+	require.Equal(t, []*TestPermission{TestPermissionDelete}, s.Members())
+	require.Panics(t, func() { s.Add(&TestPermission{New("admin")}) })
+
+	_, err = TryNewSetOf(&TestRefUnbound{})
+	require.ErrorIs(t, err, ErrNotBound)
+}
+
+func TestSetOf_Copy(t *testing.T) {
+	a := NewSetOf(TestPermissionRead)
+	b := a
+	b.Add(TestPermissionWrite)
+	require.True(t, b.Has(TestPermissionWrite))
+	require.False(t, a.Has(TestPermissionWrite))
+
+	c := b
+	c.Remove(TestPermissionRead)
+	require.False(t, c.Has(TestPermissionRead))
+	require.True(t, b.Has(TestPermissionRead))
+	require.True(t, a.Has(TestPermissionRead))
+}
+
+func TestSetOf_Operations(t *testing.T) {
+	rw := NewSetOf(TestPermissionRead, TestPermissionWrite)
+	wd := NewSetOf(TestPermissionWrite, TestPermissionDelete)
+
+	require.Equal(t, []*TestPermission{TestPermissionRead, TestPermissionWrite, TestPermissionDelete}, rw.Union(wd).Members())
+	require.Equal(t, []*TestPermission{TestPermissionWrite}, rw.Intersect(wd).Members())
+	require.Equal(t, []*TestPermission{}, rw.Intersect(SetOf[*TestPermission]{}).Members())
+
+	// Operations return new collections
+	require.Equal(t, 2, rw.Len())
+	require.Equal(t, 2, wd.Len())
+}
+
+func TestSetOf_JSON(t *testing.T) {
+	type composite struct {
+		Allowed SetOf[*TestPermission] `json:"allowed"`
+	}
+
+	got, err := json.Marshal(composite{NewSetOf(TestPermissionDelete, TestPermissionRead)})
+	require.NoError(t, err)
+	require.Equal(t, "{\"allowed\":[\"read\",\"delete\"]}", string(got))
+
+	got, err = json.Marshal(composite{})
+	require.NoError(t, err)
+	require.Equal(t, "{\"allowed\":[]}", string(got))
+
+	tests := []struct {
+		name    string
+		data    string
+		want    []*TestPermission
+		wantErr error
+	}{
+		{
+			name: "array",
+			data: "{\"allowed\":[\"delete\",\"read\",\"read\"]}",
+			want: []*TestPermission{TestPermissionRead, TestPermissionDelete},
+		},
+		{
+			name: "null",
+			data: "{\"allowed\":null}",
+			want: []*TestPermission{},
+		},
+		{
+			name:    "unknown value",
+			data:    "{\"allowed\":[\"admin\"]}",
+			wantErr: ErrUnknownValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := composite{NewSetOf(TestPermissionWrite)}
+			err := json.Unmarshal([]byte(tt.data), &c)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c.Allowed.Members())
+		})
+	}
+
+	var s SetOf[*TestPermission]
+	require.Error(t, json.Unmarshal([]byte("\"read\""), &s))
+}
+
+func TestSetOf_SQL(t *testing.T) {
+	value, err := NewSetOf(TestPermissionDelete, TestPermissionRead).Value()
+	require.NoError(t, err)
+	require.Equal(t, "read,delete", value)
+	require.Equal(t, "read,delete", NewSetOf(TestPermissionDelete, TestPermissionRead).String())
+
+	value, err = SetOf[*TestPermission]{}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "", value)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    []*TestPermission
+		wantErr error
+	}{
+		{
+			name:  "comma separated",
+			value: []byte("write, read"),
+			want:  []*TestPermission{TestPermissionRead, TestPermissionWrite},
+		},
+		{
+			name:  "json",
+			value: "[\"delete\"]",
+			want:  []*TestPermission{TestPermissionDelete},
+		},
+		{
+			name:  "empty",
+			value: "",
+			want:  []*TestPermission{},
+		},
+		{
+			name:  "null",
+			value: nil,
+			want:  []*TestPermission{},
+		},
+		{
+			name:    "unknown value",
+			value:   "read,admin",
+			wantErr: ErrUnknownValue,
+		},
+		{
+			name:    "unsupported type",
+			value:   int64(1),
+			wantErr: ErrConversion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSetOf(TestPermissionWrite)
+			err := s.Scan(tt.value)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, s.Members())
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Transition declares the members reachable from a member.
+// A transition without targets declares a final member.
+type Transition[T Underlying] struct {
+	// The source member
+	From Enummer[T]
+	// The allowed target members
+	To []Enummer[T]
+}
+
+// Transitions is a state machine over the members of a set.
+// The first member of the set is the initial state.
+// Every member must declare its transitions:
+// members declared without targets are the final states.
+type Transitions[T Underlying] struct {
+	// The members of the state machine
+	set *Set[T]
+	// The target positions by source position, in the set order
+	next [][]int
+}
+
+// NewTransitions creates a new state machine over the members of the set.
+// It panics if a member is not in the set, if a member does not declare
+// its transitions or if a member cannot be reached from the initial member.
+//
+// Example:
+//
+//	TestStateTransitions = enum.NewTransitions(TestStates,
+//		enum.Transition[string]{From: TestStateUnknown, To: []enum.Enummer[string]{TestStatePassed, TestStateFailed}},
+//		enum.Transition[string]{From: TestStateFailed, To: []enum.Enummer[string]{TestStatePassed}},
+//		enum.Transition[string]{From: TestStatePassed},
+//	)
+func NewTransitions[T Underlying](set *Set[T], transitions ...Transition[T]) *Transitions[T] {
+	t, err := TryNewTransitions(set, transitions...)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// TryNewTransitions is like NewTransitions but returns an error instead of panicking.
+// It returns a DeadStateError if a member does not declare its transitions
+// and an UnreachableStateError if a member cannot be reached from the initial member.
+func TryNewTransitions[T Underlying](set *Set[T], transitions ...Transition[T]) (*Transitions[T], error) {
+	edges := make([][]bool, len(set.members))
+	for _, tr := range transitions {
+		from, err := set.TryIndex(tr.From)
+		if err != nil {
+			return nil, err
+		}
+		if edges[from] == nil {
+			edges[from] = make([]bool, len(set.members))
+		}
+		for _, e := range tr.To {
+			to, err := set.TryIndex(e)
+			if err != nil {
+				return nil, err
+			}
+			edges[from][to] = true
+		}
+	}
+
+	t := &Transitions[T]{set: set, next: make([][]int, len(set.members))}
+	for from, targets := range edges {
+		if targets == nil {
+			return nil, &DeadStateError{Value: set.members[from], Type: t.enumType()}
+		}
+		for to, ok := range targets {
+			if ok {
+				t.next[from] = append(t.next[from], to)
+			}
+		}
+	}
+
+	// Walk the graph from the initial member
+	reached := make([]bool, len(set.members))
+	reached[0] = true
+	queue := []int{0}
+	for len(queue) > 0 {
+		from := queue[0]
+		queue = queue[1:]
+		for _, to := range t.next[from] {
+			if !reached[to] {
+				reached[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+	for pos, ok := range reached {
+		if !ok {
+			return nil, &UnreachableStateError{Value: set.members[pos], Type: t.enumType()}
+		}
+	}
+	return t, nil
+}
+
+// Initial returns the initial member of the state machine.
+func (t *Transitions[T]) Initial() Enummer[T] {
+	return t.set.members[0]
+}
+
+// CanTransition returns true if the transition from a member to another is allowed.
+func (t *Transitions[T]) CanTransition(from, to Enummer[T]) bool {
+	return t.CheckTransition(from, to) == nil
+}
+
+// CheckTransition returns an IllegalTransitionError
+// if the transition from a member to another is not allowed.
+// It returns a NotInListError or a TypeMismatchError if a member is not in the set.
+func (t *Transitions[T]) CheckTransition(from, to Enummer[T]) error {
+	fromPos, err := t.set.TryIndex(from)
+	if err != nil {
+		return err
+	}
+	toPos, err := t.set.TryIndex(to)
+	if err != nil {
+		return err
+	}
+	for _, pos := range t.next[fromPos] {
+		if pos == toPos {
+			return nil
+		}
+	}
+	return &IllegalTransitionError{From: from, To: to, Type: t.enumType()}
+}
+
+// Next returns the members reachable from the given member in the set order.
+// It returns nil if the member is final or not in the set.
+func (t *Transitions[T]) Next(from Enummer[T]) []Enummer[T] {
+	pos, err := t.set.TryIndex(from)
+	if err != nil || len(t.next[pos]) == 0 {
+		return nil
+	}
+	members := make([]Enummer[T], len(t.next[pos]))
+	for i, to := range t.next[pos] {
+		members[i] = t.set.members[to]
+	}
+	return members
+}
+
+// IsFinal returns true if no transition is allowed from the given member.
+func (t *Transitions[T]) IsFinal(e Enummer[T]) bool {
+	pos, err := t.set.TryIndex(e)
+	return err == nil && len(t.next[pos]) == 0
+}
+
+// DOT returns the Graphviz DOT representation of the state machine.
+// Members are labeled with their string representation
+// and final members are drawn with a double circle.
+func (t *Transitions[T]) DOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", t.enumType().Name())
+	for pos, e := range t.set.members {
+		shape := "circle"
+		if len(t.next[pos]) == 0 {
+			shape = "doublecircle"
+		}
+		fmt.Fprintf(&b, "\ts%d [label=%q, shape=%s];\n", pos, e.String(), shape)
+	}
+	for from, targets := range t.next {
+		for _, to := range targets {
+			fmt.Fprintf(&b, "\ts%d -> s%d;\n", from, to)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid returns the Mermaid state diagram of the state machine.
+// Members are labeled with their string representation.
+func (t *Transitions[T]) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	for pos, e := range t.set.members {
+		fmt.Fprintf(&b, "\tstate %q as s%d\n", e.String(), pos)
+	}
+	b.WriteString("\t[*] --> s0\n")
+	for from, targets := range t.next {
+		for _, to := range targets {
+			fmt.Fprintf(&b, "\ts%d --> s%d\n", from, to)
+		}
+		if len(targets) == 0 {
+			fmt.Fprintf(&b, "\ts%d --> [*]\n", from)
+		}
+	}
+	return b.String()
+}
+
+// enumType returns the type of the members.
+func (t *Transitions[T]) enumType() reflect.Type {
+	return getEnummerType(t.set.members[0])
+}
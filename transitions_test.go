@@ -0,0 +1,151 @@
+package enum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test type used as lifecycle states
+type TestLifecycle struct {
+	Enum[string]
+}
+
+var (
+	TestLifecyclePending = &TestLifecycle{New("pending", WithName("Pending"))}
+	TestLifecycleRunning = &TestLifecycle{New("running", WithName("Running"))}
+	TestLifecycleFailed  = &TestLifecycle{New("failed", WithName("Failed"))}
+	TestLifecycleDone    = &TestLifecycle{New("done", WithName("Done"))}
+	TestLifecycles       = NewSet[string](
+		TestLifecyclePending,
+		TestLifecycleRunning,
+		TestLifecycleFailed,
+		TestLifecycleDone,
+	)
+)
+
+func newTestLifecycleTransitions() *Transitions[string] {
+	return NewTransitions(TestLifecycles,
+		Transition[string]{From: TestLifecyclePending, To: []Enummer[string]{TestLifecycleRunning}},
+		Transition[string]{From: TestLifecycleRunning, To: []Enummer[string]{TestLifecycleDone, TestLifecycleFailed}},
+		Transition[string]{From: TestLifecycleFailed, To: []Enummer[string]{TestLifecycleRunning}},
+		Transition[string]{From: TestLifecycleDone},
+	)
+}
+
+func TestNewTransitions(t *testing.T) {
+	tests := []struct {
+		name        string
+		transitions []Transition[string]
+		wantErr     error
+	}{
+		{
+			name: "dead state",
+			transitions: []Transition[string]{
+				{From: TestLifecyclePending, To: []Enummer[string]{TestLifecycleRunning}},
+				{From: TestLifecycleRunning, To: []Enummer[string]{TestLifecycleDone, TestLifecycleFailed}},
+				{From: TestLifecycleDone},
+			},
+			wantErr: ErrDeadState,
+		},
+		{
+			name: "unreachable state",
+			transitions: []Transition[string]{
+				{From: TestLifecyclePending, To: []Enummer[string]{TestLifecycleRunning}},
+				{From: TestLifecycleRunning, To: []Enummer[string]{TestLifecycleDone}},
+				{From: TestLifecycleFailed, To: []Enummer[string]{TestLifecycleDone}},
+				{From: TestLifecycleDone},
+			},
+			wantErr: ErrUnreachableState,
+		},
+		{
+			name: "not in set",
+			transitions: []Transition[string]{
+				{From: TestLifecyclePending, To: []Enummer[string]{&TestLifecycle{New("cancelled")}}},
+			},
+			wantErr: ErrNotInList,
+		},
+		{
+			name: "type mismatch",
+			transitions: []Transition[string]{
+				{From: TestRefHello},
+			},
+			wantErr: ErrTypeMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Panics(t, func() { NewTransitions(TestLifecycles, tt.transitions...) })
+			_, err := TryNewTransitions(TestLifecycles, tt.transitions...)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	var deadErr *DeadStateError
+	_, err := TryNewTransitions(TestLifecycles, tests[0].transitions...)
+	require.ErrorAs(t, err, &deadErr)
+	require.Same(t, TestLifecycleFailed, deadErr.Value)
+}
+
+func TestTransitions_CanTransition(t *testing.T) {
+	tr := newTestLifecycleTransitions()
+	require.Same(t, TestLifecyclePending, tr.Initial())
+
+	require.True(t, tr.CanTransition(TestLifecyclePending, TestLifecycleRunning))
+	require.True(t, tr.CanTransition(TestLifecycleFailed, TestLifecycleRunning))
+	require.False(t, tr.CanTransition(TestLifecyclePending, TestLifecycleDone))
+	require.False(t, tr.CanTransition(TestLifecycleDone, TestLifecyclePending))
+	require.False(t, tr.CanTransition(TestLifecycleRunning, TestLifecycleRunning))
+
+	err := tr.CheckTransition(TestLifecycleDone, TestLifecycleRunning)
+	require.ErrorIs(t, err, ErrIllegalTransition)
+	var transitionErr *IllegalTransitionError
+	require.ErrorAs(t, err, &transitionErr)
+	require.Same(t, TestLifecycleDone, transitionErr.From)
+	require.Same(t, TestLifecycleRunning, transitionErr.To)
+
+	require.ErrorIs(t, tr.CheckTransition(TestLifecyclePending, &TestLifecycle{New("cancelled")}), ErrNotInList)
+	require.ErrorIs(t, tr.CheckTransition(TestRefHello, TestLifecycleDone), ErrTypeMismatch)
+}
+
+func TestTransitions_Next(t *testing.T) {
+	tr := newTestLifecycleTransitions()
+	require.Equal(t, []Enummer[string]{TestLifecycleFailed, TestLifecycleDone}, tr.Next(TestLifecycleRunning))
+	require.Nil(t, tr.Next(TestLifecycleDone))
+	require.Nil(t, tr.Next(TestRefHello))
+
+	require.True(t, tr.IsFinal(TestLifecycleDone))
+	require.False(t, tr.IsFinal(TestLifecycleFailed))
+	require.False(t, tr.IsFinal(TestRefHello))
+}
+
+func TestTransitions_DOT(t *testing.T) {
+	want := `digraph "TestLifecycle" {
+	s0 [label="Pending", shape=circle];
+	s1 [label="Running", shape=circle];
+	s2 [label="Failed", shape=circle];
+	s3 [label="Done", shape=doublecircle];
+	s0 -> s1;
+	s1 -> s2;
+	s1 -> s3;
+	s2 -> s1;
+}
+`
+	require.Equal(t, want, newTestLifecycleTransitions().DOT())
+}
+
+func TestTransitions_Mermaid(t *testing.T) {
+	want := `stateDiagram-v2
+	state "Pending" as s0
+	state "Running" as s1
+	state "Failed" as s2
+	state "Done" as s3
+	[*] --> s0
+	s0 --> s1
+	s1 --> s2
+	s1 --> s3
+	s2 --> s1
+	s3 --> [*]
+`
+	require.Equal(t, want, newTestLifecycleTransitions().Mermaid())
+}
@@ -0,0 +1,94 @@
+package enum
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// MarshalXML implements the xml.Marshaler interface.
+// The enum is encoded as the text of the element, like its JSON value.
+func (e Enum[T]) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return enc.EncodeElement(e.val, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// The text of the element is converted like the text of the enum.
+// An Enum does not know its set, so the value is not validated:
+// use a Ref to validate it against the members of the bound set.
+// It returns a FrozenError if the enum is a frozen member
+// and the element holds another value.
+func (e *Enum[T]) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := dec.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	return e.unmarshalXMLText(text)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// The enum is encoded as the value of the attribute, like its JSON value.
+func (e Enum[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: fmt.Sprintf("%v", e.val)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// The value of the attribute is converted like the text of an element.
+func (e *Enum[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return e.unmarshalXMLText(attr.Value)
+}
+
+// unmarshalXMLText sets the enum to the value of the XML text.
+func (e *Enum[T]) unmarshalXMLText(text string) error {
+	return e.UnmarshalText([]byte(text))
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// A reference without member nor default is omitted.
+func (r Ref[E]) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	var zero E
+	member := r.Get()
+	if member == zero {
+		return nil
+	}
+	return enc.EncodeElement(member, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// The text of the element is matched like the text of the reference:
+// against the values, then the names of the members.
+// It returns an UnknownValueError if the value is not a member of the bound set.
+func (r *Ref[E]) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := dec.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	return r.unmarshalXMLText(text)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// A reference without member nor default is omitted.
+func (r Ref[E]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	var zero E
+	member := r.Get()
+	if member == zero {
+		return xml.Attr{}, nil
+	}
+	m, ok := any(member).(xml.MarshalerAttr)
+	if !ok {
+		return xml.Attr{}, fmt.Errorf("enum: '%T' does not implement xml.MarshalerAttr", member)
+	}
+	return m.MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// The value of the attribute is matched like the text of an element.
+func (r *Ref[E]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return r.unmarshalXMLText(attr.Value)
+}
+
+// unmarshalXMLText sets the reference to the member matching the XML text.
+func (r *Ref[E]) unmarshalXMLText(text string) error {
+	return r.resolve(func(b binding) (any, error) {
+		return b.resolveText([]byte(text))
+	})
+}
@@ -0,0 +1,139 @@
+package enum
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnum_XML(t *testing.T) {
+	type composite struct {
+		XMLName xml.Name        `xml:"test"`
+		String  *TestTypeString `xml:"string"`
+		Int     *TestTypeInt    `xml:"int,attr"`
+	}
+
+	got, err := xml.Marshal(composite{String: &TestTypeString{New("hello", WithName("Hello"))}, Int: &TestTypeInt{New(1, WithName("One"))}})
+	require.NoError(t, err)
+	require.Equal(t, "<test int=\"1\"><string>hello</string></test>", string(got))
+
+	tests := []struct {
+		name       string
+		data       string
+		wantString string
+		wantInt    int
+		wantErr    error
+	}{
+		{
+			name:       "element and attribute",
+			data:       "<test int=\"2\"><string>world</string></test>",
+			wantString: "world",
+			wantInt:    2,
+		},
+		{
+			name:    "invalid attribute",
+			data:    "<test int=\"two\"><string>world</string></test>",
+			wantErr: ErrConversion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c composite
+			err := xml.Unmarshal([]byte(tt.data), &c)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantString, c.String.GetValue())
+			require.Equal(t, tt.wantInt, c.Int.GetValue())
+		})
+	}
+
+	// Frozen members are not overwritten
+	frozenComposite := struct {
+		XMLName xml.Name         `xml:"test"`
+		State   *TestFrozenState `xml:"state,attr"`
+	}{State: TestFrozenStatePassed}
+	err = xml.Unmarshal([]byte("<test state=\"failed\"></test>"), &frozenComposite)
+	require.ErrorIs(t, err, ErrFrozen)
+	require.Equal(t, "passed", TestFrozenStatePassed.GetValue())
+}
+
+func TestRef_XML(t *testing.T) {
+	type composite struct {
+		XMLName xml.Name            `xml:"test"`
+		String  Ref[*TestRefString] `xml:"string"`
+		Int     Ref[*TestRefInt]    `xml:"int,attr"`
+	}
+
+	got, err := xml.Marshal(composite{String: NewRef(TestRefWorld), Int: NewRef(TestRefTwo)})
+	require.NoError(t, err)
+	require.Equal(t, "<test int=\"2\"><string>world</string></test>", string(got))
+
+	got, err = xml.Marshal(composite{})
+	require.NoError(t, err)
+	require.Equal(t, "<test></test>", string(got))
+
+	tests := []struct {
+		name    string
+		data    string
+		want    composite
+		wantErr error
+	}{
+		{
+			name: "members",
+			data: "<test int=\"1\"><string>hello</string></test>",
+			want: composite{XMLName: xml.Name{Local: "test"}, String: NewRef(TestRefHello), Int: NewRef(TestRefOne)},
+		},
+		{
+			name: "attribute name",
+			data: "<test int=\"Two\"><string>world</string></test>",
+			want: composite{XMLName: xml.Name{Local: "test"}, String: NewRef(TestRefWorld), Int: NewRef(TestRefTwo)},
+		},
+		{
+			name:    "unknown element value",
+			data:    "<test><string>xxx</string></test>",
+			wantErr: ErrUnknownValue,
+		},
+		{
+			name:    "unknown attribute value",
+			data:    "<test int=\"3\"></test>",
+			wantErr: ErrUnknownValue,
+		},
+		{
+			name:    "unknown attribute name",
+			data:    "<test int=\"Three\"></test>",
+			wantErr: ErrUnknownValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c composite
+			err := xml.Unmarshal([]byte(tt.data), &c)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c)
+		})
+	}
+
+	// Elements and attributes are matched like text
+	var ints struct {
+		XMLName xml.Name         `xml:"test"`
+		Attr    Ref[*TestRefInt] `xml:"attr,attr"`
+		Element Ref[*TestRefInt] `xml:"element"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte("<test attr=\"One\"><element>Two</element></test>"), &ints))
+	require.Same(t, TestRefOne, ints.Attr.Get())
+	require.Same(t, TestRefTwo, ints.Element.Get())
+	require.ErrorIs(t, xml.Unmarshal([]byte("<test><element>3</element></test>"), &ints), ErrUnknownValue)
+
+	var unbound struct {
+		State Ref[*TestRefUnbound] `xml:"state"`
+	}
+	require.ErrorIs(t, xml.Unmarshal([]byte("<test><state>hello</state></test>"), &unbound), ErrNotBound)
+}
@@ -0,0 +1,137 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (e Enum[T]) MarshalYAML() (any, error) {
+	return e.val, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// It returns a FrozenError if the enum is a frozen member
+// and the node holds another value.
+func (e *Enum[T]) UnmarshalYAML(node *yaml.Node) error {
+	val := e.val
+	if err := node.Decode(&val); err != nil {
+		return err
+	}
+	if err := e.set(val); err != nil {
+		return yamlError(node, err)
+	}
+	return nil
+}
+
+// ParseYAML parses the given YAML node into an Enummer.
+// Members are matched like ParseJSON.
+// It returns an UnknownValueError with the position of the node
+// if the value is not a member of the set.
+func (s *Set[T]) ParseYAML(node *yaml.Node) (Enummer[T], error) {
+	var val T
+	if err := node.Decode(&val); err != nil {
+		return nil, err
+	}
+	e, err := s.TryParse(val)
+	if err != nil {
+		return nil, yamlError(node, err)
+	}
+	return e, nil
+}
+
+// resolveYAML implements the binding interface.
+func (s *Set[T]) resolveYAML(node *yaml.Node) (any, error) {
+	return s.ParseYAML(node)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// A reference without member nor default is encoded as null.
+func (r Ref[E]) MarshalYAML() (any, error) {
+	var zero E
+	member := r.Get()
+	if member == zero {
+		return nil, nil
+	}
+	return member, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// The YAML decoder leaves the reference unchanged for null values.
+func (r *Ref[E]) UnmarshalYAML(node *yaml.Node) error {
+	return r.resolve(func(b binding) (any, error) {
+		return b.resolveYAML(node)
+	})
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// Flags are encoded as a sequence of member values.
+func (f Flags[E]) MarshalYAML() (any, error) {
+	return f.Members(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// It accepts a sequence of member values or an integer bitmask.
+// The YAML decoder leaves the flags unchanged for null values.
+func (f *Flags[E]) UnmarshalYAML(node *yaml.Node) error {
+	b, err := bindingOf[E]()
+	if err != nil {
+		return err
+	}
+	if node.Kind != yaml.SequenceNode {
+		var mask uint64
+		if node.Decode(&mask) != nil {
+			return yamlError(node, &ConversionError{Value: node.Value, Type: reflect.TypeOf(f).Elem(), Err: errUnsupportedType})
+		}
+		if err := f.setBits(b, mask); err != nil {
+			return yamlError(node, err)
+		}
+		return nil
+	}
+	var result Flags[E]
+	for _, value := range node.Content {
+		member, err := b.resolveYAML(value)
+		if err != nil {
+			return err
+		}
+		if err := result.add(b, member); err != nil {
+			return yamlError(value, err)
+		}
+	}
+	*f = result
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// The collection is encoded as a sequence of member values.
+func (s SetOf[E]) MarshalYAML() (any, error) {
+	return s.Members(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// It returns an UnknownValueError if a value is not a member of the bound set.
+// The YAML decoder leaves the collection unchanged for null values.
+func (s *SetOf[E]) UnmarshalYAML(node *yaml.Node) error {
+	b, err := bindingOf[E]()
+	if err != nil {
+		return err
+	}
+	if node.Kind != yaml.SequenceNode {
+		return yamlError(node, &ConversionError{Value: node.Value, Type: reflect.TypeOf(s).Elem(), Err: errUnsupportedType})
+	}
+	members := make([]any, len(node.Content))
+	for i, value := range node.Content {
+		if members[i], err = b.resolveYAML(value); err != nil {
+			return err
+		}
+	}
+	*s = SetOf[E]{}
+	return s.add(b, members)
+}
+
+// yamlError adds the position of the node to the error.
+func yamlError(node *yaml.Node, err error) error {
+	return fmt.Errorf("yaml: line %d, column %d: %w", node.Line, node.Column, err)
+}
@@ -0,0 +1,216 @@
+package enum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEnum_YAML(t *testing.T) {
+	type composite struct {
+		String *TestTypeString `yaml:"string"`
+		Int    *TestTypeInt    `yaml:"int"`
+	}
+
+	got, err := yaml.Marshal(composite{&TestTypeString{New("hello", WithName("Hello"))}, &TestTypeInt{New(1)}})
+	require.NoError(t, err)
+	require.Equal(t, "string: hello\nint: 1\n", string(got))
+
+	var c composite
+	require.NoError(t, yaml.Unmarshal([]byte("string: world\nint: 2\n"), &c))
+	require.Equal(t, "world", c.String.GetValue())
+	require.Equal(t, 2, c.Int.GetValue())
+
+	c = composite{Int: &TestTypeInt{New(1)}}
+	require.NoError(t, yaml.Unmarshal([]byte("int: null\n"), &c))
+	require.Nil(t, c.Int)
+	require.Error(t, yaml.Unmarshal([]byte("int: [1]\n"), &c))
+
+	// Frozen members are not overwritten
+	c2 := struct {
+		State *TestFrozenState `yaml:"state"`
+	}{TestFrozenStatePassed}
+	err = yaml.Unmarshal([]byte("state: failed\n"), &c2)
+	require.ErrorIs(t, err, ErrFrozen)
+	require.EqualError(t, err, "yaml: line 1, column 8: enum: cannot decode 'failed' into frozen member 'passed' of 'enum.TestFrozenState'")
+	require.Equal(t, "passed", TestFrozenStatePassed.GetValue())
+}
+
+func TestRef_YAML(t *testing.T) {
+	type composite struct {
+		String  Ref[*TestRefString]  `yaml:"string"`
+		Int     Ref[*TestRefInt]     `yaml:"int"`
+		Default Ref[*TestRefDefault] `yaml:"default"`
+	}
+
+	got, err := yaml.Marshal(composite{String: NewRef(TestRefWorld), Int: NewRef(TestRefTwo)})
+	require.NoError(t, err)
+	require.Equal(t, "string: world\nint: 2\ndefault: unknown\n", string(got))
+
+	got, err = yaml.Marshal(composite{})
+	require.NoError(t, err)
+	require.Equal(t, "string: null\nint: null\ndefault: unknown\n", string(got))
+
+	tests := []struct {
+		name    string
+		data    string
+		want    composite
+		wantErr string
+	}{
+		{
+			name: "members",
+			data: "string: hello\nint: 2\ndefault: known\n",
+			want: composite{String: NewRef(TestRefHello), Int: NewRef(TestRefTwo), Default: NewRef(TestRefDefaultKnown)},
+		},
+		{
+			name: "null",
+			data: "string: ~\nint: null\n",
+			want: composite{String: NewRef(TestRefWorld), Int: NewRef(TestRefOne)},
+		},
+		{
+			name:    "unknown value",
+			data:    "string: hello\nint: 3\n",
+			wantErr: "yaml: line 2, column 6: enum: unknown value '3' for '*enum.TestRefInt'",
+		},
+		{
+			name:    "invalid type",
+			data:    "string:\n  - hello\n",
+			wantErr: "cannot unmarshal !!seq into string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := composite{String: NewRef(TestRefWorld), Int: NewRef(TestRefOne)}
+			err := yaml.Unmarshal([]byte(tt.data), &c)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c)
+		})
+	}
+
+	var c composite
+	err = yaml.Unmarshal([]byte("int: 3\n"), &c)
+	require.ErrorIs(t, err, ErrUnknownValue)
+	var unknownErr *UnknownValueError
+	require.ErrorAs(t, err, &unknownErr)
+	require.Equal(t, 3, unknownErr.Value)
+
+	var unbound struct {
+		State Ref[*TestRefUnbound] `yaml:"state"`
+	}
+	require.ErrorIs(t, yaml.Unmarshal([]byte("state: hello\n"), &unbound), ErrNotBound)
+}
+
+func TestFlags_YAML(t *testing.T) {
+	type composite struct {
+		Permissions Flags[*TestPermission] `yaml:"permissions"`
+	}
+
+	got, err := yaml.Marshal(composite{NewFlags(TestPermissionDelete, TestPermissionRead)})
+	require.NoError(t, err)
+	require.Equal(t, "permissions:\n    - read\n    - delete\n", string(got))
+
+	tests := []struct {
+		name    string
+		data    string
+		want    []*TestPermission
+		wantErr string
+	}{
+		{
+			name: "sequence",
+			data: "permissions: [write, read]\n",
+			want: []*TestPermission{TestPermissionRead, TestPermissionWrite},
+		},
+		{
+			name: "bitmask",
+			data: "permissions: 5\n",
+			want: []*TestPermission{TestPermissionRead, TestPermissionDelete},
+		},
+		{
+			name: "null",
+			data: "permissions: null\n",
+			want: []*TestPermission{TestPermissionWrite},
+		},
+		{
+			name:    "unknown value",
+			data:    "permissions:\n  - read\n  - admin\n",
+			wantErr: "yaml: line 3, column 5: enum: unknown value 'admin'",
+		},
+		{
+			name:    "unknown bit",
+			data:    "permissions: 8\n",
+			wantErr: "yaml: line 1, column 14: enum: unknown value '8'",
+		},
+		{
+			name:    "invalid type",
+			data:    "permissions: read\n",
+			wantErr: "yaml: line 1, column 14: enum: cannot convert 'read'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := composite{NewFlags(TestPermissionWrite)}
+			err := yaml.Unmarshal([]byte(tt.data), &c)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c.Permissions.Members())
+		})
+	}
+}
+
+func TestSetOf_YAML(t *testing.T) {
+	type composite struct {
+		Allowed SetOf[*TestPermission] `yaml:"allowed"`
+	}
+
+	got, err := yaml.Marshal(composite{NewSetOf(TestPermissionDelete, TestPermissionRead)})
+	require.NoError(t, err)
+	require.Equal(t, "allowed:\n    - read\n    - delete\n", string(got))
+
+	tests := []struct {
+		name    string
+		data    string
+		want    []*TestPermission
+		wantErr string
+	}{
+		{
+			name: "sequence",
+			data: "allowed: [delete, read, read]\n",
+			want: []*TestPermission{TestPermissionRead, TestPermissionDelete},
+		},
+		{
+			name: "null",
+			data: "allowed: ~\n",
+			want: []*TestPermission{TestPermissionWrite},
+		},
+		{
+			name:    "unknown value",
+			data:    "allowed: [read, admin]\n",
+			wantErr: "yaml: line 1, column 17: enum: unknown value 'admin'",
+		},
+		{
+			name:    "invalid type",
+			data:    "allowed: read\n",
+			wantErr: "yaml: line 1, column 10: enum: cannot convert 'read'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := composite{NewSetOf(TestPermissionWrite)}
+			err := yaml.Unmarshal([]byte(tt.data), &c)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, c.Allowed.Members())
+		})
+	}
+}